@@ -0,0 +1,146 @@
+// Package billing interprets the trial, billing period and free trial hour fields of organizations and subscriptions,
+// so that every service reports the same status for the same records.
+package billing
+
+import (
+	"time"
+
+	"github.com/3dsim/organization-goclient/models"
+	"github.com/go-openapi/strfmt"
+)
+
+// Clock returns the current time.  Use time.Now outside of tests.
+type Clock func() time.Time
+
+// State is the billing state of a subscription.
+type State string
+
+// The states reported by StatusCalculator.
+const (
+	// InTrial means the trial period of the subscription has not ended.
+	InTrial State = "InTrial"
+	// TrialExpiringSoon means the trial period ends within the trial warning of the calculator.
+	TrialExpiringSoon State = "TrialExpiringSoon"
+	// Active means the subscription is paid for and within its current billing period.
+	Active State = "Active"
+	// PastPeriodEnd means the current billing period ended without the subscription being renewed.
+	PastPeriodEnd State = "PastPeriodEnd"
+	// Canceled means the subscription was canceled or is inactive.
+	Canceled State = "Canceled"
+)
+
+// DefaultTrialWarning is how long before the end of a trial a subscription is reported as TrialExpiringSoon.
+const DefaultTrialWarning = 3 * 24 * time.Hour
+
+// Status is the billing status of a subscription at a point in time.
+type Status struct {
+	State State
+	// Ends is the end of the trial for InTrial and TrialExpiringSoon, and the end of the current billing period
+	// otherwise.  It is nil if the subscription does not say when that is.
+	Ends *time.Time
+	// DaysRemaining is the number of days until Ends, with a partial day counted as a whole day.  It is 0 if Ends is
+	// nil or has passed.
+	DaysRemaining int
+	// WarningEmailDue is true when the trial is expiring soon and no warning email has been sent yet, according to
+	// WarningEmailSentAt.
+	WarningEmailDue bool
+}
+
+// StatusCalculator computes the Status of subscriptions.
+type StatusCalculator struct {
+	clock        Clock
+	trialWarning time.Duration
+}
+
+// NewStatusCalculator creates a StatusCalculator that reads the current time from clock and warns DefaultTrialWarning
+// before a trial ends.  A nil clock uses time.Now.
+func NewStatusCalculator(clock Clock) *StatusCalculator {
+	if clock == nil {
+		clock = time.Now
+	}
+	return &StatusCalculator{clock: clock, trialWarning: DefaultTrialWarning}
+}
+
+// WithTrialWarning returns a copy of the calculator that reports TrialExpiringSoon within trialWarning of the end of
+// a trial.
+func (c *StatusCalculator) WithTrialWarning(trialWarning time.Duration) *StatusCalculator {
+	copied := *c
+	copied.trialWarning = trialWarning
+	return &copied
+}
+
+// Status returns the status of subscription.  plan is the plan of the subscription and may be nil; it is only used to
+// work out the end of the trial from TrialPeriodDays when the subscription has no TrialEnd.  The rules are applied in
+// order:
+//
+//   - A subscription that is not Active or has CanceledAt set is Canceled.  Ends is the end of the current billing
+//     period, during which a canceled subscription can still be used.
+//   - A subscription whose trial has not ended is InTrial, or TrialExpiringSoon within the trial warning.
+//   - A subscription whose CurrentPeriodEnd has passed is PastPeriodEnd.
+//   - Any other subscription is Active.
+func (c *StatusCalculator) Status(subscription *models.Subscription, plan *models.Plan) Status {
+	now := c.clock()
+	periodEnd := dateTime(subscription.CurrentPeriodEnd)
+
+	if !subscription.Active || subscription.CanceledAt != nil {
+		return newStatus(Canceled, periodEnd, now)
+	}
+	if trialEnd := c.trialEnd(subscription, plan); trialEnd != nil && now.Before(*trialEnd) {
+		if trialEnd.Sub(now) <= c.trialWarning {
+			status := newStatus(TrialExpiringSoon, trialEnd, now)
+			status.WarningEmailDue = subscription.WarningEmailSentAt == nil
+			return status
+		}
+		return newStatus(InTrial, trialEnd, now)
+	}
+	if periodEnd != nil && !now.Before(*periodEnd) {
+		return newStatus(PastPeriodEnd, periodEnd, now)
+	}
+	return newStatus(Active, periodEnd, now)
+}
+
+// trialEnd returns TrialEnd of the subscription, or the start of the subscription plus the TrialPeriodDays of the plan.
+func (c *StatusCalculator) trialEnd(subscription *models.Subscription, plan *models.Plan) *time.Time {
+	if subscription.TrialEnd != nil {
+		return dateTime(*subscription.TrialEnd)
+	}
+	if plan == nil || plan.TrialPeriodDays == nil || *plan.TrialPeriodDays <= 0 {
+		return nil
+	}
+	start := dateTime(subscription.CurrentPeriodStart)
+	if start == nil {
+		start = dateTime(subscription.CreatedAt)
+	}
+	if start == nil {
+		return nil
+	}
+	end := start.AddDate(0, 0, int(*plan.TrialPeriodDays))
+	return &end
+}
+
+func newStatus(state State, ends *time.Time, now time.Time) Status {
+	return Status{State: state, Ends: ends, DaysRemaining: daysUntil(ends, now)}
+}
+
+// daysUntil returns the number of days from now until end, rounding a partial day up.
+func daysUntil(end *time.Time, now time.Time) int {
+	if end == nil || !end.After(now) {
+		return 0
+	}
+	const day = 24 * time.Hour
+	remaining := end.Sub(now)
+	days := int(remaining / day)
+	if remaining%day != 0 {
+		days++
+	}
+	return days
+}
+
+// dateTime returns dt as a time, or nil if dt is the zero time of an unset field.
+func dateTime(dt strfmt.DateTime) *time.Time {
+	t := time.Time(dt)
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
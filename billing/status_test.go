@@ -0,0 +1,171 @@
+package billing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/3dsim/organization-goclient/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+)
+
+var now = time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func clock() time.Time {
+	return now
+}
+
+func at(t time.Time) *strfmt.DateTime {
+	d := strfmt.DateTime(t)
+	return &d
+}
+
+func days(n int) time.Duration {
+	return time.Duration(n) * 24 * time.Hour
+}
+
+func TestStatusExpectsStateAndDaysRemaining(t *testing.T) {
+	periodStart := strfmt.DateTime(now.Add(-days(10)))
+	periodEnd := strfmt.DateTime(now.Add(days(20)))
+	trialPlan := &models.Plan{ID: 1, TrialPeriodDays: swag.Int32(14)}
+	tests := []struct {
+		name          string
+		subscription  *models.Subscription
+		plan          *models.Plan
+		state         State
+		daysRemaining int
+		emailDue      bool
+	}{
+		{
+			name:          "trial end in the future",
+			subscription:  &models.Subscription{Active: true, TrialEnd: at(now.Add(days(10))), CurrentPeriodEnd: periodEnd},
+			state:         InTrial,
+			daysRemaining: 10,
+		},
+		{
+			name:          "trial end within the warning",
+			subscription:  &models.Subscription{Active: true, TrialEnd: at(now.Add(36 * time.Hour)), CurrentPeriodEnd: periodEnd},
+			state:         TrialExpiringSoon,
+			daysRemaining: 2,
+			emailDue:      true,
+		},
+		{
+			name: "trial end within the warning and warning email sent",
+			subscription: &models.Subscription{Active: true, TrialEnd: at(now.Add(days(1))), CurrentPeriodEnd: periodEnd,
+				WarningEmailSentAt: at(now.Add(-time.Hour))},
+			state:         TrialExpiringSoon,
+			daysRemaining: 1,
+		},
+		{
+			name:          "trial end from the trial period days of the plan",
+			subscription:  &models.Subscription{Active: true, CurrentPeriodStart: periodStart, CurrentPeriodEnd: periodEnd},
+			plan:          trialPlan,
+			state:         InTrial,
+			daysRemaining: 4,
+		},
+		{
+			name:          "trial ended",
+			subscription:  &models.Subscription{Active: true, TrialEnd: at(now.Add(-time.Hour)), CurrentPeriodEnd: periodEnd},
+			state:         Active,
+			daysRemaining: 20,
+		},
+		{
+			name:          "plan without trial",
+			subscription:  &models.Subscription{Active: true, CurrentPeriodStart: periodStart, CurrentPeriodEnd: periodEnd},
+			plan:          &models.Plan{ID: 2, TrialPeriodDays: swag.Int32(0)},
+			state:         Active,
+			daysRemaining: 20,
+		},
+		{
+			name:         "period ended",
+			subscription: &models.Subscription{Active: true, CurrentPeriodEnd: strfmt.DateTime(now.Add(-time.Minute))},
+			state:        PastPeriodEnd,
+		},
+		{
+			name: "canceled during the period",
+			subscription: &models.Subscription{Active: true, TrialEnd: at(now.Add(days(10))), CurrentPeriodEnd: periodEnd,
+				CanceledAt: at(now.Add(-time.Hour))},
+			state:         Canceled,
+			daysRemaining: 20,
+		},
+		{
+			name:         "inactive",
+			subscription: &models.Subscription{CurrentPeriodEnd: strfmt.DateTime(now.Add(-days(1)))},
+			state:        Canceled,
+		},
+		{
+			name:         "no dates",
+			subscription: &models.Subscription{Active: true},
+			state:        Active,
+		},
+	}
+	calculator := NewStatusCalculator(clock)
+
+	for _, test := range tests {
+		// act
+		status := calculator.Status(test.subscription, test.plan)
+
+		// assert
+		assert.Equal(t, test.state, status.State, "Expected states to match for "+test.name)
+		assert.Equal(t, test.daysRemaining, status.DaysRemaining, "Expected days remaining to match for "+test.name)
+		assert.Equal(t, test.emailDue, status.WarningEmailDue, "Expected warning email due to match for "+test.name)
+	}
+}
+
+func TestStatusWhenInTrialExpectsEndsIsTrialEnd(t *testing.T) {
+	// arrange
+	trialEnd := now.Add(days(10))
+	subscription := &models.Subscription{Active: true, TrialEnd: at(trialEnd), CurrentPeriodEnd: strfmt.DateTime(now.Add(days(30)))}
+
+	// act
+	status := NewStatusCalculator(clock).Status(subscription, nil)
+
+	// assert
+	if assert.NotNil(t, status.Ends, "Expected an end") {
+		assert.Equal(t, trialEnd, *status.Ends, "Expected the trial end")
+	}
+}
+
+func TestStatusWhenClockAdvancesExpectsStateChanges(t *testing.T) {
+	// arrange
+	current := now
+	calculator := NewStatusCalculator(func() time.Time { return current })
+	subscription := &models.Subscription{Active: true, TrialEnd: at(now.Add(days(5))), CurrentPeriodEnd: strfmt.DateTime(now.Add(days(30)))}
+
+	// act
+	states := []State{}
+	for _, offset := range []int{0, 3, 5, 30} {
+		current = now.Add(days(offset))
+		states = append(states, calculator.Status(subscription, nil).State)
+	}
+
+	// assert
+	assert.Equal(t, []State{InTrial, TrialExpiringSoon, Active, PastPeriodEnd}, states, "Expected states to follow the clock")
+}
+
+func TestWithTrialWarningExpectsWarningUsedAndOriginalUnchanged(t *testing.T) {
+	// arrange
+	calculator := NewStatusCalculator(clock)
+	subscription := &models.Subscription{Active: true, TrialEnd: at(now.Add(days(5)))}
+
+	// act
+	warned := calculator.WithTrialWarning(days(7)).Status(subscription, nil)
+	original := calculator.Status(subscription, nil)
+
+	// assert
+	assert.Equal(t, TrialExpiringSoon, warned.State, "Expected the longer warning to apply")
+	assert.Equal(t, InTrial, original.State, "Expected the original calculator to keep the default warning")
+}
+
+func TestNewStatusCalculatorWhenNilClockExpectsTimeNow(t *testing.T) {
+	// arrange
+	subscription := &models.Subscription{Active: true, CurrentPeriodEnd: strfmt.DateTime(time.Now().Add(days(2)))}
+
+	// act
+	status := NewStatusCalculator(nil).Status(subscription, nil)
+
+	// assert
+	assert.Equal(t, Active, status.State, "Expected the subscription to be active")
+	assert.Equal(t, 2, status.DaysRemaining, "Expected 2 days remaining")
+}
@@ -0,0 +1,93 @@
+package billing
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/3dsim/organization-goclient/models"
+)
+
+// DefaultTrialHourThresholds are the fractions of the free trial hours of an organization at which FreeTrialHours
+// reports a warning when no thresholds are given.
+var DefaultTrialHourThresholds = []float64{0.5, 0.75, 0.9}
+
+// Errors returned by TrialHours.SubmissionError.
+var (
+	ErrSaasAgreementNotAccepted = errors.New("billing: the organization has not accepted the SaaS agreement")
+	ErrFreeTrialHoursExhausted  = errors.New("billing: the organization has no free trial hours remaining")
+)
+
+// TrialHours is the free trial hour usage of an organization.
+type TrialHours struct {
+	OrganizationID int32
+	// Allowed is the FreeTrialHours of the organization.
+	Allowed float64
+	// Consumed is the number of hours the organization has used, as given to FreeTrialHours.
+	Consumed float64
+	// Remaining is Allowed minus Consumed, or 0 once the hours are used up.
+	Remaining float64
+	// CrossedThresholds are the warning thresholds reached by Consumed, in ascending order.  A threshold is reached once
+	// Consumed is at least that fraction of Allowed.
+	CrossedThresholds []float64
+	// AgreementBlocksUsage is true if the organization has not accepted the SaaS agreement, in which case it may not
+	// use the application regardless of its remaining hours.
+	AgreementBlocksUsage bool
+}
+
+// FreeTrialHours returns the free trial hour usage of org given the number of hours it has consumed.  thresholds are
+// the fractions of the free trial hours, between 0 and 1, at which to warn the organization; DefaultTrialHourThresholds
+// is used if none are given.  An organization without free trial hours has crossed every threshold.  An organization
+// is only considered to have accepted the SaaS agreement if SaasAgreementAccepted is set to true.
+func FreeTrialHours(org *models.Organization, consumedHours float64, thresholds ...float64) TrialHours {
+	if len(thresholds) == 0 {
+		thresholds = DefaultTrialHourThresholds
+	}
+	hours := TrialHours{
+		OrganizationID:       org.ID,
+		Consumed:             consumedHours,
+		AgreementBlocksUsage: org.SaasAgreementAccepted == nil || !*org.SaasAgreementAccepted,
+	}
+	if org.FreeTrialHours != nil && *org.FreeTrialHours > 0 {
+		hours.Allowed = float64(*org.FreeTrialHours)
+	}
+	if consumedHours < hours.Allowed {
+		hours.Remaining = hours.Allowed - consumedHours
+	}
+
+	used := 1.0
+	if hours.Allowed > 0 {
+		used = consumedHours / hours.Allowed
+	}
+	for _, threshold := range thresholds {
+		if used >= threshold {
+			hours.CrossedThresholds = append(hours.CrossedThresholds, threshold)
+		}
+	}
+	sort.Float64s(hours.CrossedThresholds)
+	return hours
+}
+
+// Exhausted reports whether the organization has no free trial hours remaining.
+func (h TrialHours) Exhausted() bool {
+	return h.Remaining <= 0
+}
+
+// HighestThreshold returns the highest warning threshold crossed, and false if none was crossed.
+func (h TrialHours) HighestThreshold() (float64, bool) {
+	if len(h.CrossedThresholds) == 0 {
+		return 0, false
+	}
+	return h.CrossedThresholds[len(h.CrossedThresholds)-1], true
+}
+
+// SubmissionError returns why the organization may not submit a job on its free trial hours, or nil if it may.
+// ErrSaasAgreementNotAccepted takes precedence over ErrFreeTrialHoursExhausted.
+func (h TrialHours) SubmissionError() error {
+	if h.AgreementBlocksUsage {
+		return ErrSaasAgreementNotAccepted
+	}
+	if h.Exhausted() {
+		return ErrFreeTrialHoursExhausted
+	}
+	return nil
+}
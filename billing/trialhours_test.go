@@ -0,0 +1,93 @@
+package billing
+
+import (
+	"testing"
+
+	"github.com/3dsim/organization-goclient/models"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+)
+
+func trialOrganization(freeTrialHours int32, agreementAccepted bool) *models.Organization {
+	return &models.Organization{
+		ID:                    7,
+		FreeTrialHours:        swag.Int32(freeTrialHours),
+		SaasAgreementAccepted: swag.Bool(agreementAccepted),
+	}
+}
+
+func TestFreeTrialHoursWhenHoursRemainingExpectsRemainingAndNoError(t *testing.T) {
+	// act
+	hours := FreeTrialHours(trialOrganization(100, true), 40)
+
+	// assert
+	assert.Equal(t, int32(7), hours.OrganizationID, "Expected organization ids to match")
+	assert.Equal(t, 100.0, hours.Allowed, "Expected the free trial hours of the organization")
+	assert.Equal(t, 40.0, hours.Consumed, "Expected the consumed hours")
+	assert.Equal(t, 60.0, hours.Remaining, "Expected 60 hours remaining")
+	assert.Empty(t, hours.CrossedThresholds, "Expected no thresholds crossed")
+	_, crossed := hours.HighestThreshold()
+	assert.False(t, crossed, "Expected no highest threshold")
+	assert.False(t, hours.Exhausted(), "Expected hours not to be exhausted")
+	assert.Nil(t, hours.SubmissionError(), "Expected submissions to be allowed")
+}
+
+func TestFreeTrialHoursWhenDefaultThresholdsCrossedExpectsThresholds(t *testing.T) {
+	// act
+	hours := FreeTrialHours(trialOrganization(100, true), 80)
+
+	// assert
+	assert.Equal(t, []float64{0.5, 0.75}, hours.CrossedThresholds, "Expected the crossed default thresholds")
+	highest, crossed := hours.HighestThreshold()
+	assert.True(t, crossed, "Expected a threshold to be crossed")
+	assert.Equal(t, 0.75, highest, "Expected the highest crossed threshold")
+}
+
+func TestFreeTrialHoursWhenThresholdsGivenExpectsSortedCrossedThresholds(t *testing.T) {
+	// act
+	hours := FreeTrialHours(trialOrganization(10, true), 9, 0.95, 0.9, 0.25)
+
+	// assert
+	assert.Equal(t, []float64{0.25, 0.9}, hours.CrossedThresholds, "Expected the crossed thresholds in ascending order")
+}
+
+func TestFreeTrialHoursWhenHoursUsedUpExpectsExhausted(t *testing.T) {
+	// act
+	hours := FreeTrialHours(trialOrganization(10, true), 12.5)
+
+	// assert
+	assert.Equal(t, 0.0, hours.Remaining, "Expected no hours remaining")
+	assert.True(t, hours.Exhausted(), "Expected hours to be exhausted")
+	assert.Equal(t, []float64{0.5, 0.75, 0.9}, hours.CrossedThresholds, "Expected every threshold crossed")
+	assert.Equal(t, ErrFreeTrialHoursExhausted, hours.SubmissionError(), "Expected submissions to be blocked")
+}
+
+func TestFreeTrialHoursWhenNoFreeTrialHoursExpectsExhausted(t *testing.T) {
+	// arrange
+	org := trialOrganization(0, true)
+	org.FreeTrialHours = nil
+
+	// act
+	hours := FreeTrialHours(org, 0)
+
+	// assert
+	assert.Equal(t, 0.0, hours.Allowed, "Expected no free trial hours")
+	assert.True(t, hours.Exhausted(), "Expected hours to be exhausted")
+	assert.Equal(t, []float64{0.5, 0.75, 0.9}, hours.CrossedThresholds, "Expected every threshold crossed")
+}
+
+func TestFreeTrialHoursWhenAgreementNotAcceptedExpectsBlocked(t *testing.T) {
+	// arrange
+	notAccepted := trialOrganization(100, false)
+	unset := trialOrganization(100, false)
+	unset.SaasAgreementAccepted = nil
+
+	for _, org := range []*models.Organization{notAccepted, unset} {
+		// act
+		hours := FreeTrialHours(org, 200)
+
+		// assert
+		assert.True(t, hours.AgreementBlocksUsage, "Expected the agreement to block usage")
+		assert.Equal(t, ErrSaasAgreementNotAccepted, hours.SubmissionError(), "Expected the agreement error to take precedence")
+	}
+}
@@ -0,0 +1,243 @@
+// Package cache provides a read-through cache in front of an organization.Client.
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/3dsim/organization-goclient/organization"
+)
+
+// Method identifies a cached read method of organization.Client.  Both the plain and the Ctx variant of a method,
+// and for the list methods also the WithOptions variants, share one Method.
+type Method string
+
+// The cached methods.
+const (
+	MethodOrganizations             Method = "Organizations"
+	MethodOrganization              Method = "Organization"
+	MethodSubscriptions             Method = "Subscriptions"
+	MethodOrganizationSubscriptions Method = "OrganizationSubscriptions"
+	MethodSubscription              Method = "Subscription"
+	MethodPlan                      Method = "Plan"
+	MethodPlans                     Method = "Plans"
+	MethodOrganizationUsers         Method = "OrganizationUsers"
+)
+
+// Defaults used by New.  Only Plan, Plans and Organization are cached unless WithTTL is used to cache more methods.
+const (
+	DefaultMaxEntries      = 1000
+	DefaultPlanTTL         = 10 * time.Minute
+	DefaultOrganizationTTL = time.Minute
+)
+
+// Option configures a Client created by New.
+type Option func(*Client)
+
+// WithTTL sets how long results of method are cached.  A ttl of 0 turns caching of method off.
+func WithTTL(method Method, ttl time.Duration) Option {
+	return func(c *Client) {
+		c.ttls[method] = ttl
+	}
+}
+
+// WithMaxEntries sets the maximum number of results cached.  When it is reached the least recently used result is
+// evicted.  The default is DefaultMaxEntries.
+func WithMaxEntries(maxEntries int) Option {
+	return func(c *Client) {
+		c.maxEntries = maxEntries
+	}
+}
+
+// Stats counts the lookups of cached methods.
+type Stats struct {
+	// Hits is the number of lookups answered from the cache.
+	Hits uint64
+	// Misses is the number of lookups sent to the underlying client.
+	Misses uint64
+	// Shared is the number of lookups that waited for an identical concurrent lookup instead of sending their own.
+	Shared uint64
+	// Evictions is the number of results removed to stay within the maximum number of entries.
+	Evictions uint64
+	// Entries is the number of results currently cached, including expired ones not yet removed.
+	Entries int
+}
+
+// Client is an organization.Client that caches the results of read methods of another Client for a time to live set
+// per Method.  Concurrent identical lookups that miss the cache are sent to the underlying client once; a caller whose
+// context is done stops waiting without failing the others.  Errors are never cached.
+//
+// Results are stored encoded, so every call returns its own copy that the caller may modify.  Writes made through the
+// Client invalidate every cached result they change: organization and user writes invalidate the organization, see
+// InvalidateOrganization, and subscription writes invalidate the subscription, see InvalidateSubscription.  Writes
+// made elsewhere are only seen once the cached results expire.
+type Client struct {
+	next       organization.Client
+	ttls       map[Method]time.Duration
+	maxEntries int
+	now        func() time.Time
+
+	mu    sync.Mutex
+	lru   *lru
+	stats Stats
+	// generation is incremented by every invalidation so that lookups started before it neither store their result
+	// nor are shared with lookups started after it.
+	generation uint64
+
+	flights flightGroup
+}
+
+var _ organization.Client = (*Client)(nil)
+
+// New creates a Client that caches the results of next.
+func New(next organization.Client, opts ...Option) *Client {
+	c := &Client{
+		next: next,
+		ttls: map[Method]time.Duration{
+			MethodPlan:         DefaultPlanTTL,
+			MethodPlans:        DefaultPlanTTL,
+			MethodOrganization: DefaultOrganizationTTL,
+		},
+		maxEntries: DefaultMaxEntries,
+		now:        time.Now,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.maxEntries < 1 {
+		c.maxEntries = 1
+	}
+	c.lru = newLRU(c.maxEntries)
+	return c
+}
+
+// Stats returns the lookup statistics of the cache since it was created.
+func (c *Client) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats := c.stats
+	stats.Entries = c.lru.len()
+	return stats
+}
+
+// InvalidateOrganization removes the cached organization with id organizationID, the lists of organizations, and the
+// cached subscriptions and users of the organization.
+func (c *Client) InvalidateOrganization(organizationID int32) {
+	c.invalidate(func(e *entry) bool {
+		return e.organizationID == organizationID || e.method == MethodOrganizations
+	})
+}
+
+// InvalidateSubscription removes every cached result that includes the subscriptions of the organization with id
+// organizationID: the subscriptions of the organization, the lists of subscriptions, and, since a
+// models.Organization embeds its Subscriptions, the organization and the lists of organizations.  The users of the
+// organization stay cached.
+func (c *Client) InvalidateSubscription(organizationID, subscriptionID int32) {
+	subscriptionKey := lookup{method: MethodSubscription, args: []int32{organizationID, subscriptionID}}.key()
+	c.invalidate(func(e *entry) bool {
+		switch e.method {
+		case MethodSubscriptions, MethodOrganizations:
+			return true
+		case MethodOrganization, MethodOrganizationSubscriptions:
+			return e.organizationID == organizationID
+		case MethodSubscription:
+			return e.key == subscriptionKey
+		}
+		return false
+	})
+}
+
+// InvalidatePlans removes every cached plan and list of plans.
+func (c *Client) InvalidatePlans() {
+	c.invalidate(func(e *entry) bool {
+		return e.method == MethodPlan || e.method == MethodPlans
+	})
+}
+
+// Purge removes every cached result.
+func (c *Client) Purge() {
+	c.invalidate(func(*entry) bool { return true })
+}
+
+func (c *Client) invalidate(match func(*entry) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	c.lru.removeIf(match)
+}
+
+func (c *Client) caches(method Method) bool {
+	return c.ttls[method] > 0
+}
+
+// lookup is a read of one cached method with one set of arguments.
+type lookup struct {
+	method         Method
+	args           interface{}
+	organizationID int32
+}
+
+func (l lookup) key() string {
+	args, _ := json.Marshal(l.args)
+	return string(l.method) + " " + string(args)
+}
+
+// get decodes the cached result of l into result, a pointer to the result type of the method.  On a miss it calls
+// fetch, sharing the call with identical concurrent lookups, and caches the result.  fetch must use the context it is
+// given, which is not canceled with ctx, since the call is shared; see flightGroup.do.
+func (c *Client) get(ctx context.Context, l lookup, result interface{}, fetch func(context.Context) (interface{}, error)) error {
+	key := l.key()
+	c.mu.Lock()
+	value, ok := c.lru.get(key, c.now())
+	if ok {
+		c.stats.Hits++
+	}
+	generation := c.generation
+	c.mu.Unlock()
+	if ok {
+		return json.Unmarshal(value, result)
+	}
+
+	value, err, shared := c.flights.do(ctx, fmt.Sprintf("%d %s", generation, key), func(ctx context.Context) ([]byte, error) {
+		fetched, err := fetch(ctx)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(fetched)
+		if err != nil {
+			return nil, err
+		}
+		c.store(l, key, value, generation)
+		return value, nil
+	})
+	c.mu.Lock()
+	if shared {
+		c.stats.Shared++
+	} else {
+		c.stats.Misses++
+	}
+	c.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(value, result)
+}
+
+// store caches value unless the cache was invalidated since the lookup started.
+func (c *Client) store(l lookup, key string, value []byte, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if generation != c.generation {
+		return
+	}
+	c.stats.Evictions += uint64(c.lru.add(&entry{
+		key:            key,
+		method:         l.method,
+		value:          value,
+		expires:        c.now().Add(c.ttls[l.method]),
+		organizationID: l.organizationID,
+	}))
+}
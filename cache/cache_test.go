@@ -0,0 +1,507 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/3dsim/organization-goclient/models"
+	"github.com/3dsim/organization-goclient/organization"
+	"github.com/3dsim/organization-goclient/organization/organizationfakes"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+)
+
+var now = time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestClient(opts ...Option) (*Client, *organizationfakes.FakeClient, *time.Time) {
+	fake := &organizationfakes.FakeClient{}
+	c := New(fake, opts...)
+	current := now
+	c.now = func() time.Time { return current }
+	return c, fake, &current
+}
+
+func planStub(ctx context.Context, planID int32) (*models.Plan, error) {
+	return &models.Plan{ID: planID, Name: swag.String("Plan")}, nil
+}
+
+func TestPlanWhenCalledTwiceExpectsSecondCallFromCache(t *testing.T) {
+	// arrange
+	c, fake, _ := newTestClient()
+	fake.PlanCtxStub = planStub
+
+	// act
+	first, err1 := c.Plan(1)
+	first.Name = swag.String("Modified")
+	second, err2 := c.Plan(1)
+
+	// assert
+	assert.Nil(t, err1, "Expected no error")
+	assert.Nil(t, err2, "Expected no error")
+	assert.Equal(t, 1, fake.PlanCtxCallCount(), "Expected the underlying client to be called once")
+	assert.Equal(t, "Plan", *second.Name, "Expected every call to return its own copy")
+	assert.Equal(t, Stats{Hits: 1, Misses: 1, Entries: 1}, c.Stats(), "Expected one hit and one miss")
+}
+
+func TestPlanWhenTTLElapsedExpectsUnderlyingClientCalledAgain(t *testing.T) {
+	// arrange
+	c, fake, current := newTestClient(WithTTL(MethodPlan, time.Minute))
+	fake.PlanCtxStub = planStub
+	c.Plan(1)
+
+	// act
+	*current = now.Add(59 * time.Second)
+	c.Plan(1)
+	*current = now.Add(time.Minute)
+	c.Plan(1)
+
+	// assert
+	assert.Equal(t, 2, fake.PlanCtxCallCount(), "Expected the expired plan to be fetched again")
+	assert.Equal(t, uint64(1), c.Stats().Hits, "Expected one hit before expiry")
+}
+
+func TestOrganizationUsersWhenNoTTLExpectsNotCached(t *testing.T) {
+	// arrange
+	c, fake, _ := newTestClient()
+	fake.OrganizationUsersCtxReturns([]*models.User{{UserID: "auth0|1"}}, nil)
+
+	// act
+	c.OrganizationUsers(1)
+	users, err := c.OrganizationUsers(1)
+
+	// assert
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, users, 1, "Expected the users of the underlying client")
+	assert.Equal(t, 2, fake.OrganizationUsersCtxCallCount(), "Expected every call to reach the underlying client")
+	assert.Equal(t, Stats{}, c.Stats(), "Expected no lookups counted")
+}
+
+func TestOrganizationWhenErrorExpectsErrorNotCached(t *testing.T) {
+	// arrange
+	c, fake, _ := newTestClient()
+	fake.OrganizationCtxReturnsOnCall(0, nil, errors.New("unavailable"))
+	fake.OrganizationCtxReturnsOnCall(1, &models.Organization{ID: 1}, nil)
+
+	// act
+	_, err1 := c.Organization(1)
+	org, err2 := c.Organization(1)
+
+	// assert
+	assert.NotNil(t, err1, "Expected the error of the underlying client")
+	assert.Nil(t, err2, "Expected the second call to succeed")
+	assert.Equal(t, int32(1), org.ID, "Expected the organization")
+	assert.Equal(t, 2, fake.OrganizationCtxCallCount(), "Expected the error not to be cached")
+}
+
+func TestPlanWhenMaxEntriesReachedExpectsLeastRecentlyUsedEvicted(t *testing.T) {
+	// arrange
+	c, fake, _ := newTestClient(WithMaxEntries(2))
+	fake.PlanCtxStub = planStub
+	c.Plan(1)
+	c.Plan(2)
+	c.Plan(1)
+
+	// act
+	c.Plan(3)
+	c.Plan(1)
+	c.Plan(2)
+
+	// assert
+	assert.Equal(t, 4, fake.PlanCtxCallCount(), "Expected only plan 2 to be fetched again")
+	stats := c.Stats()
+	assert.Equal(t, uint64(2), stats.Evictions, "Expected plan 2 and then plan 3 to be evicted")
+	assert.Equal(t, 2, stats.Entries, "Expected the maximum number of entries")
+}
+
+func TestPlanWhenConcurrentIdenticalLookupsExpectsOneCall(t *testing.T) {
+	// arrange
+	c, fake, _ := newTestClient()
+	release := make(chan struct{})
+	fake.PlanCtxStub = func(ctx context.Context, planID int32) (*models.Plan, error) {
+		<-release
+		return planStub(ctx, planID)
+	}
+	const callers = 5
+	var wg sync.WaitGroup
+	plans := make([]*models.Plan, callers)
+
+	// act
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			plans[i], _ = c.Plan(1)
+		}(i)
+	}
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	// assert
+	assert.Equal(t, 1, fake.PlanCtxCallCount(), "Expected one call to the underlying client")
+	stats := c.Stats()
+	assert.Equal(t, uint64(1), stats.Misses, "Expected one miss")
+	assert.Equal(t, uint64(callers-1), stats.Shared, "Expected the other lookups to share the call")
+	for _, plan := range plans {
+		if assert.NotNil(t, plan, "Expected every caller to get the plan") {
+			assert.Equal(t, int32(1), plan.ID, "Expected plan ids to match")
+		}
+	}
+}
+
+func TestPlanCtxWhenFirstCallerCanceledExpectsSharedLookupToSucceedForOthers(t *testing.T) {
+	// arrange
+	c, fake, _ := newTestClient()
+	release := make(chan struct{})
+	fake.PlanCtxStub = func(ctx context.Context, planID int32) (*models.Plan, error) {
+		select {
+		case <-release:
+			return planStub(ctx, planID)
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	firstCtx, cancel := context.WithCancel(context.Background())
+	firstErr := make(chan error)
+	go func() {
+		_, err := c.PlanCtx(firstCtx, 1)
+		firstErr <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+	type result struct {
+		plan *models.Plan
+		err  error
+	}
+	second := make(chan result)
+	go func() {
+		plan, err := c.PlanCtx(context.Background(), 1)
+		second <- result{plan, err}
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	// act
+	cancel()
+	err := <-firstErr
+	close(release)
+	r := <-second
+
+	// assert
+	assert.Equal(t, context.Canceled, err, "Expected the canceled caller to get its own context error")
+	assert.Nil(t, r.err, "Expected no error for the caller that was not canceled")
+	if assert.NotNil(t, r.plan, "Expected the plan returned") {
+		assert.Equal(t, int32(1), r.plan.ID, "Expected plan ids to match")
+	}
+	assert.Equal(t, 1, fake.PlanCtxCallCount(), "Expected one call to the underlying client")
+}
+
+func TestPlanCtxWhenWaiterDeadlineExceededExpectsWaiterToReturnAtDeadline(t *testing.T) {
+	// arrange
+	c, fake, _ := newTestClient()
+	release := make(chan struct{})
+	defer close(release)
+	fake.PlanCtxStub = func(ctx context.Context, planID int32) (*models.Plan, error) {
+		<-release
+		return planStub(ctx, planID)
+	}
+	go c.Plan(1)
+	time.Sleep(50 * time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+
+	// act
+	plan, err := c.PlanCtx(ctx, 1)
+
+	// assert
+	assert.Nil(t, plan, "Expected no plan returned")
+	assert.Equal(t, context.DeadlineExceeded, err, "Expected the waiter's own deadline error")
+	assert.True(t, time.Since(start) < 200*time.Millisecond, "Expected the waiter to return at its deadline")
+}
+
+func TestPlanCtxWhenLookupPanicsExpectsWaitersErrorAndFirstCallerPanic(t *testing.T) {
+	// arrange
+	c, fake, _ := newTestClient()
+	release := make(chan struct{})
+	fake.PlanCtxStub = func(ctx context.Context, planID int32) (*models.Plan, error) {
+		<-release
+		panic("broken backend")
+	}
+	firstPanic := make(chan interface{})
+	go func() {
+		defer func() { firstPanic <- recover() }()
+		c.Plan(1)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	waiterErr := make(chan error)
+	go func() {
+		_, err := c.Plan(1)
+		waiterErr <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	// act
+	close(release)
+	recovered := <-firstPanic
+	err := <-waiterErr
+
+	// assert
+	assert.Equal(t, "broken backend", recovered, "Expected the first caller to panic with the same value")
+	assert.NotNil(t, err, "Expected the waiter to get an error")
+	assert.Equal(t, 1, fake.PlanCtxCallCount(), "Expected one call to the underlying client")
+}
+
+func TestInvalidateOrganizationExpectsOrganizationScopedResultsRemoved(t *testing.T) {
+	// arrange
+	c, fake, _ := newTestClient(WithTTL(MethodOrganizationSubscriptions, time.Minute), WithTTL(MethodOrganizations, time.Minute))
+	fake.OrganizationCtxStub = func(ctx context.Context, organizationID int32) (*models.Organization, error) {
+		return &models.Organization{ID: organizationID}, nil
+	}
+	fake.PlanCtxStub = planStub
+	c.Organization(1)
+	c.Organization(2)
+	c.OrganizationSubscriptions(1)
+	c.Organizations()
+	c.Plan(1)
+
+	// act
+	c.InvalidateOrganization(1)
+	c.Organization(1)
+	c.Organization(2)
+	c.OrganizationSubscriptions(1)
+	c.Organizations()
+	c.Plan(1)
+
+	// assert
+	assert.Equal(t, 3, fake.OrganizationCtxCallCount(), "Expected only organization 1 to be fetched again")
+	assert.Equal(t, 2, fake.OrganizationSubscriptionsCtxCallCount(), "Expected the subscriptions of organization 1 to be fetched again")
+	assert.Equal(t, 2, fake.OrganizationsWithOptionsCtxCallCount(), "Expected the organization list to be fetched again")
+	assert.Equal(t, 1, fake.PlanCtxCallCount(), "Expected the plan to stay cached")
+}
+
+func TestUpdateOrganizationExpectsOrganizationInvalidated(t *testing.T) {
+	// arrange
+	c, fake, _ := newTestClient()
+	fake.OrganizationCtxReturnsOnCall(0, &models.Organization{ID: 1, Name: swag.String("Before")}, nil)
+	fake.OrganizationCtxReturnsOnCall(1, &models.Organization{ID: 1, Name: swag.String("After")}, nil)
+	fake.UpdateOrganizationCtxReturns(&models.Organization{ID: 1, Name: swag.String("After")}, nil)
+	org, _ := c.Organization(1)
+	org.Name = swag.String("After")
+
+	// act
+	_, err := c.UpdateOrganization(org)
+	updated, _ := c.Organization(1)
+
+	// assert
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, 1, fake.UpdateOrganizationCtxCallCount(), "Expected the update to reach the underlying client")
+	assert.Equal(t, "After", *updated.Name, "Expected the updated organization")
+}
+
+func TestOrganizationWhenInvalidatedDuringLookupExpectsResultNotCached(t *testing.T) {
+	// arrange
+	c, fake, _ := newTestClient()
+	fake.OrganizationCtxStub = func(ctx context.Context, organizationID int32) (*models.Organization, error) {
+		if fake.OrganizationCtxCallCount() == 1 {
+			c.InvalidateOrganization(organizationID)
+		}
+		return &models.Organization{ID: organizationID}, nil
+	}
+
+	// act
+	c.Organization(1)
+	c.Organization(1)
+	c.Organization(1)
+
+	// assert
+	assert.Equal(t, 2, fake.OrganizationCtxCallCount(), "Expected the result read before the invalidation not to be cached")
+}
+
+func TestPlansWhenDifferentOptionsExpectsSeparateEntries(t *testing.T) {
+	// arrange
+	c, fake, _ := newTestClient()
+	fake.PlansCtxReturns([]*models.Plan{{ID: 1}}, nil)
+
+	// act
+	c.Plans(organization.ListPlansOptions{Available: swag.Bool(true)})
+	c.Plans(organization.ListPlansOptions{Available: swag.Bool(false)})
+	c.Plans(organization.ListPlansOptions{Available: swag.Bool(true)})
+	c.InvalidatePlans()
+	c.Plans(organization.ListPlansOptions{Available: swag.Bool(true)})
+
+	// assert
+	assert.Equal(t, 3, fake.PlansCtxCallCount(), "Expected one call per distinct options and one after invalidation")
+}
+
+// subscriptionBackend is an in memory organization api for one organization with one subscription, so that tests can
+// check reads through the cache against the state of the api.
+type subscriptionBackend struct {
+	mu           sync.Mutex
+	subscription models.Subscription
+}
+
+func newSubscriptionBackend(fake *organizationfakes.FakeClient) *subscriptionBackend {
+	b := &subscriptionBackend{subscription: models.Subscription{ID: 5, OrganizationID: 1, PlanID: 10, Active: true}}
+	fake.OrganizationCtxStub = func(ctx context.Context, organizationID int32) (*models.Organization, error) {
+		org := &models.Organization{ID: organizationID}
+		if organizationID == 1 {
+			org.Subscriptions = []*models.Subscription{b.read()}
+		}
+		return org, nil
+	}
+	fake.OrganizationSubscriptionsCtxStub = func(ctx context.Context, organizationID int32) ([]*models.Subscription, error) {
+		return []*models.Subscription{b.read()}, nil
+	}
+	fake.SubscriptionCtxStub = func(ctx context.Context, organizationID, subscriptionID int32) (*models.Subscription, error) {
+		return b.read(), nil
+	}
+	fake.SubscriptionsWithOptionsCtxStub = func(ctx context.Context, options organization.ListSubscriptionsOptions) ([]*models.Subscription, error) {
+		return []*models.Subscription{b.read()}, nil
+	}
+	fake.UpdateSubscriptionCtxStub = func(ctx context.Context, subscription *models.Subscription) (*models.Subscription, error) {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.subscription = *subscription
+		updated := b.subscription
+		return &updated, nil
+	}
+	return b
+}
+
+func (b *subscriptionBackend) read() *models.Subscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	s := b.subscription
+	return &s
+}
+
+func newSubscriptionCacheTestClient() (*Client, *organizationfakes.FakeClient) {
+	c, fake, _ := newTestClient(
+		WithTTL(MethodSubscription, time.Minute),
+		WithTTL(MethodSubscriptions, time.Minute),
+		WithTTL(MethodOrganizationSubscriptions, time.Minute),
+		WithTTL(MethodOrganizationUsers, time.Minute),
+	)
+	newSubscriptionBackend(fake)
+	return c, fake
+}
+
+func TestUpdateSubscriptionExpectsNoStaleReads(t *testing.T) {
+	// arrange
+	c, _ := newSubscriptionCacheTestClient()
+	limit := swag.Int32(10)
+	c.Organization(1)
+	c.OrganizationSubscriptions(1)
+	c.Subscriptions(limit)
+	subscription, _ := c.Subscription(1, 5)
+	subscription.PlanID = 20
+
+	// act
+	_, err := c.UpdateSubscription(subscription)
+	org, _ := c.Organization(1)
+	orgSubscriptions, _ := c.OrganizationSubscriptions(1)
+	subscriptions, _ := c.Subscriptions(limit)
+	read, _ := c.Subscription(1, 5)
+
+	// assert
+	assert.Nil(t, err, "Expected no error")
+	if assert.Len(t, org.Subscriptions, 1, "Expected the embedded subscription") {
+		assert.Equal(t, int32(20), org.Subscriptions[0].PlanID, "Expected the organization to embed the updated subscription")
+	}
+	if assert.Len(t, orgSubscriptions, 1, "Expected the subscriptions of the organization") {
+		assert.Equal(t, int32(20), orgSubscriptions[0].PlanID, "Expected the updated subscription of the organization")
+	}
+	if assert.Len(t, subscriptions, 1, "Expected the subscription list page") {
+		assert.Equal(t, int32(20), subscriptions[0].PlanID, "Expected the updated subscription in the list page")
+	}
+	assert.Equal(t, int32(20), read.PlanID, "Expected the updated subscription")
+}
+
+func TestUpdateSubscriptionExpectsUnrelatedResultsStillCached(t *testing.T) {
+	// arrange
+	c, fake := newSubscriptionCacheTestClient()
+	fake.PlanCtxStub = planStub
+	c.Organization(2)
+	c.OrganizationUsers(1)
+	c.Plan(10)
+	c.Subscription(1, 6)
+	subscription, _ := c.Subscription(1, 5)
+
+	// act
+	c.UpdateSubscription(subscription)
+	c.Organization(2)
+	c.OrganizationUsers(1)
+	c.Plan(10)
+	c.Subscription(1, 6)
+
+	// assert
+	assert.Equal(t, 1, fake.OrganizationCtxCallCount(), "Expected another organization to stay cached")
+	assert.Equal(t, 1, fake.OrganizationUsersCtxCallCount(), "Expected the users of the organization to stay cached")
+	assert.Equal(t, 1, fake.PlanCtxCallCount(), "Expected the plan to stay cached")
+	assert.Equal(t, 2, fake.SubscriptionCtxCallCount(), "Expected another subscription to stay cached")
+}
+
+func TestUpdateSubscriptionWhenUpdateFailsExpectsSubscriptionInvalidated(t *testing.T) {
+	// arrange
+	c, fake := newSubscriptionCacheTestClient()
+	subscription, _ := c.Subscription(1, 5)
+	fake.UpdateSubscriptionCtxStub = nil
+	fake.UpdateSubscriptionCtxReturns(nil, errors.New("timeout"))
+
+	// act
+	_, err := c.UpdateSubscription(subscription)
+	c.Subscription(1, 5)
+
+	// assert
+	assert.NotNil(t, err, "Expected the error of the underlying client")
+	assert.Equal(t, 2, fake.SubscriptionCtxCallCount(), "Expected the subscription to be fetched again")
+}
+
+func TestCreateOrganizationWhenNoOrganizationReturnedExpectsListInvalidated(t *testing.T) {
+	// arrange
+	c, fake, _ := newTestClient(WithTTL(MethodOrganizations, time.Minute))
+	fake.CreateOrganizationCtxReturns(nil, nil)
+	c.Organizations()
+
+	// act
+	_, err := c.CreateOrganization(&models.Organization{})
+	c.Organizations()
+
+	// assert
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, 2, fake.OrganizationsWithOptionsCtxCallCount(), "Expected the organization list to be fetched again")
+}
+
+func TestCreateSubscriptionWhenNoSubscriptionReturnedExpectsSubscriptionsInvalidated(t *testing.T) {
+	// arrange
+	c, fake := newSubscriptionCacheTestClient()
+	fake.CreateSubscriptionCtxReturns(nil, nil)
+	c.OrganizationSubscriptions(1)
+
+	// act
+	_, err := c.CreateSubscription(1, 4, "Invoice")
+	c.OrganizationSubscriptions(1)
+
+	// assert
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, 2, fake.OrganizationSubscriptionsCtxCallCount(), "Expected the subscriptions to be fetched again")
+}
+
+func TestCancelSubscriptionExpectsSubscriptionInvalidated(t *testing.T) {
+	// arrange
+	c, fake := newSubscriptionCacheTestClient()
+	c.Subscription(1, 5)
+	c.Organization(1)
+
+	// act
+	c.CancelSubscription(1, 5, "admin@3dsim.com")
+	c.Subscription(1, 5)
+	c.Organization(1)
+
+	// assert
+	assert.Equal(t, 1, fake.CancelSubscriptionCtxCallCount(), "Expected the cancel to reach the underlying client")
+	assert.Equal(t, 2, fake.SubscriptionCtxCallCount(), "Expected the subscription to be fetched again")
+	assert.Equal(t, 2, fake.OrganizationCtxCallCount(), "Expected the organization to be fetched again")
+}
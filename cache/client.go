@@ -0,0 +1,283 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/3dsim/organization-goclient/models"
+	"github.com/3dsim/organization-goclient/organization"
+)
+
+func (c *Client) Organizations() ([]*models.Organization, error) {
+	return c.OrganizationsCtx(context.Background())
+}
+
+func (c *Client) OrganizationsCtx(ctx context.Context) ([]*models.Organization, error) {
+	return c.OrganizationsWithOptionsCtx(ctx, organization.ListOrganizationsOptions{})
+}
+
+func (c *Client) OrganizationsWithOptions(options organization.ListOrganizationsOptions) ([]*models.Organization, error) {
+	return c.OrganizationsWithOptionsCtx(context.Background(), options)
+}
+
+func (c *Client) OrganizationsWithOptionsCtx(ctx context.Context, options organization.ListOrganizationsOptions) ([]*models.Organization, error) {
+	if !c.caches(MethodOrganizations) {
+		return c.next.OrganizationsWithOptionsCtx(ctx, options)
+	}
+	var organizations []*models.Organization
+	err := c.get(ctx, lookup{method: MethodOrganizations, args: options}, &organizations, func(ctx context.Context) (interface{}, error) {
+		return c.next.OrganizationsWithOptionsCtx(ctx, options)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return organizations, nil
+}
+
+func (c *Client) Organization(organizationID int32) (*models.Organization, error) {
+	return c.OrganizationCtx(context.Background(), organizationID)
+}
+
+func (c *Client) OrganizationCtx(ctx context.Context, organizationID int32) (*models.Organization, error) {
+	if !c.caches(MethodOrganization) {
+		return c.next.OrganizationCtx(ctx, organizationID)
+	}
+	var org *models.Organization
+	l := lookup{method: MethodOrganization, args: organizationID, organizationID: organizationID}
+	err := c.get(ctx, l, &org, func(ctx context.Context) (interface{}, error) {
+		return c.next.OrganizationCtx(ctx, organizationID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return org, nil
+}
+
+func (c *Client) CreateOrganization(org *models.Organization) (*models.Organization, error) {
+	return c.CreateOrganizationCtx(context.Background(), org)
+}
+
+func (c *Client) CreateOrganizationCtx(ctx context.Context, org *models.Organization) (*models.Organization, error) {
+	created, err := c.next.CreateOrganizationCtx(ctx, org)
+	if err == nil {
+		// The lists of organizations are invalidated even if the underlying client returned no organization.
+		var id int32
+		if created != nil {
+			id = created.ID
+		}
+		c.InvalidateOrganization(id)
+	}
+	return created, err
+}
+
+func (c *Client) UpdateOrganization(org *models.Organization) (*models.Organization, error) {
+	return c.UpdateOrganizationCtx(context.Background(), org)
+}
+
+func (c *Client) UpdateOrganizationCtx(ctx context.Context, org *models.Organization) (*models.Organization, error) {
+	// The organization is invalidated even if the update failed since it may have been applied before the error.
+	if org != nil {
+		defer c.InvalidateOrganization(org.ID)
+	}
+	return c.next.UpdateOrganizationCtx(ctx, org)
+}
+
+func (c *Client) DeactivateOrganization(organizationID int32) (*models.Organization, error) {
+	return c.DeactivateOrganizationCtx(context.Background(), organizationID)
+}
+
+func (c *Client) DeactivateOrganizationCtx(ctx context.Context, organizationID int32) (*models.Organization, error) {
+	defer c.InvalidateOrganization(organizationID)
+	return c.next.DeactivateOrganizationCtx(ctx, organizationID)
+}
+
+func (c *Client) Subscriptions(limit *int32) ([]*models.Subscription, error) {
+	return c.SubscriptionsCtx(context.Background(), limit)
+}
+
+func (c *Client) SubscriptionsCtx(ctx context.Context, limit *int32) ([]*models.Subscription, error) {
+	return c.SubscriptionsWithOptionsCtx(ctx, organization.ListSubscriptionsOptions{Limit: limit})
+}
+
+func (c *Client) SubscriptionsWithOptions(options organization.ListSubscriptionsOptions) ([]*models.Subscription, error) {
+	return c.SubscriptionsWithOptionsCtx(context.Background(), options)
+}
+
+func (c *Client) SubscriptionsWithOptionsCtx(ctx context.Context, options organization.ListSubscriptionsOptions) ([]*models.Subscription, error) {
+	if !c.caches(MethodSubscriptions) {
+		return c.next.SubscriptionsWithOptionsCtx(ctx, options)
+	}
+	var subscriptions []*models.Subscription
+	err := c.get(ctx, lookup{method: MethodSubscriptions, args: options}, &subscriptions, func(ctx context.Context) (interface{}, error) {
+		return c.next.SubscriptionsWithOptionsCtx(ctx, options)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+func (c *Client) OrganizationSubscriptions(organizationID int32) ([]*models.Subscription, error) {
+	return c.OrganizationSubscriptionsCtx(context.Background(), organizationID)
+}
+
+func (c *Client) OrganizationSubscriptionsCtx(ctx context.Context, organizationID int32) ([]*models.Subscription, error) {
+	if !c.caches(MethodOrganizationSubscriptions) {
+		return c.next.OrganizationSubscriptionsCtx(ctx, organizationID)
+	}
+	var subscriptions []*models.Subscription
+	l := lookup{method: MethodOrganizationSubscriptions, args: organizationID, organizationID: organizationID}
+	err := c.get(ctx, l, &subscriptions, func(ctx context.Context) (interface{}, error) {
+		return c.next.OrganizationSubscriptionsCtx(ctx, organizationID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+func (c *Client) Subscription(organizationID, subscriptionID int32) (*models.Subscription, error) {
+	return c.SubscriptionCtx(context.Background(), organizationID, subscriptionID)
+}
+
+func (c *Client) SubscriptionCtx(ctx context.Context, organizationID, subscriptionID int32) (*models.Subscription, error) {
+	if !c.caches(MethodSubscription) {
+		return c.next.SubscriptionCtx(ctx, organizationID, subscriptionID)
+	}
+	var subscription *models.Subscription
+	l := lookup{method: MethodSubscription, args: []int32{organizationID, subscriptionID}, organizationID: organizationID}
+	err := c.get(ctx, l, &subscription, func(ctx context.Context) (interface{}, error) {
+		return c.next.SubscriptionCtx(ctx, organizationID, subscriptionID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func (c *Client) UpdateSubscription(subscription *models.Subscription) (*models.Subscription, error) {
+	return c.UpdateSubscriptionCtx(context.Background(), subscription)
+}
+
+func (c *Client) UpdateSubscriptionCtx(ctx context.Context, subscription *models.Subscription) (*models.Subscription, error) {
+	if subscription == nil {
+		return c.next.UpdateSubscriptionCtx(ctx, subscription)
+	}
+	// The subscription is invalidated even if the update failed since it may have been applied before the error.
+	defer c.InvalidateSubscription(subscription.OrganizationID, subscription.ID)
+	updated, err := c.next.UpdateSubscriptionCtx(ctx, subscription)
+	if err == nil && updated != nil && updated.OrganizationID != subscription.OrganizationID {
+		c.InvalidateSubscription(updated.OrganizationID, updated.ID)
+	}
+	return updated, err
+}
+
+func (c *Client) CreateSubscription(organizationID, planID int32, paymentMethod string) (*models.Subscription, error) {
+	return c.CreateSubscriptionCtx(context.Background(), organizationID, planID, paymentMethod)
+}
+
+func (c *Client) CreateSubscriptionCtx(ctx context.Context, organizationID, planID int32, paymentMethod string) (*models.Subscription, error) {
+	created, err := c.next.CreateSubscriptionCtx(ctx, organizationID, planID, paymentMethod)
+	if err == nil {
+		// The subscriptions of the organization are invalidated even if the underlying client returned no subscription.
+		var id int32
+		if created != nil {
+			id = created.ID
+		}
+		c.InvalidateSubscription(organizationID, id)
+	}
+	return created, err
+}
+
+func (c *Client) CancelSubscription(organizationID, subscriptionID int32, canceledBy string) (*models.Subscription, error) {
+	return c.CancelSubscriptionCtx(context.Background(), organizationID, subscriptionID, canceledBy)
+}
+
+func (c *Client) CancelSubscriptionCtx(ctx context.Context, organizationID, subscriptionID int32, canceledBy string) (*models.Subscription, error) {
+	defer c.InvalidateSubscription(organizationID, subscriptionID)
+	return c.next.CancelSubscriptionCtx(ctx, organizationID, subscriptionID, canceledBy)
+}
+
+func (c *Client) ReactivateSubscription(organizationID, subscriptionID int32, reactivatedBy string) (*models.Subscription, error) {
+	return c.ReactivateSubscriptionCtx(context.Background(), organizationID, subscriptionID, reactivatedBy)
+}
+
+func (c *Client) ReactivateSubscriptionCtx(ctx context.Context, organizationID, subscriptionID int32, reactivatedBy string) (*models.Subscription, error) {
+	defer c.InvalidateSubscription(organizationID, subscriptionID)
+	return c.next.ReactivateSubscriptionCtx(ctx, organizationID, subscriptionID, reactivatedBy)
+}
+
+func (c *Client) Plan(planID int32) (*models.Plan, error) {
+	return c.PlanCtx(context.Background(), planID)
+}
+
+func (c *Client) PlanCtx(ctx context.Context, planID int32) (*models.Plan, error) {
+	if !c.caches(MethodPlan) {
+		return c.next.PlanCtx(ctx, planID)
+	}
+	var plan *models.Plan
+	err := c.get(ctx, lookup{method: MethodPlan, args: planID}, &plan, func(ctx context.Context) (interface{}, error) {
+		return c.next.PlanCtx(ctx, planID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+func (c *Client) Plans(options organization.ListPlansOptions) ([]*models.Plan, error) {
+	return c.PlansCtx(context.Background(), options)
+}
+
+func (c *Client) PlansCtx(ctx context.Context, options organization.ListPlansOptions) ([]*models.Plan, error) {
+	if !c.caches(MethodPlans) {
+		return c.next.PlansCtx(ctx, options)
+	}
+	var plans []*models.Plan
+	err := c.get(ctx, lookup{method: MethodPlans, args: options}, &plans, func(ctx context.Context) (interface{}, error) {
+		return c.next.PlansCtx(ctx, options)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return plans, nil
+}
+
+func (c *Client) OrganizationUsers(organizationID int32) ([]*models.User, error) {
+	return c.OrganizationUsersCtx(context.Background(), organizationID)
+}
+
+func (c *Client) OrganizationUsersCtx(ctx context.Context, organizationID int32) ([]*models.User, error) {
+	if !c.caches(MethodOrganizationUsers) {
+		return c.next.OrganizationUsersCtx(ctx, organizationID)
+	}
+	var users []*models.User
+	l := lookup{method: MethodOrganizationUsers, args: organizationID, organizationID: organizationID}
+	err := c.get(ctx, l, &users, func(ctx context.Context) (interface{}, error) {
+		return c.next.OrganizationUsersCtx(ctx, organizationID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+func (c *Client) CreateOrganizationUser(organizationID int32, user *models.UserPost) (*models.User, error) {
+	return c.CreateOrganizationUserCtx(context.Background(), organizationID, user)
+}
+
+func (c *Client) CreateOrganizationUserCtx(ctx context.Context, organizationID int32, user *models.UserPost) (*models.User, error) {
+	created, err := c.next.CreateOrganizationUserCtx(ctx, organizationID, user)
+	if err == nil {
+		c.InvalidateOrganization(organizationID)
+	}
+	return created, err
+}
+
+// ImpersonationURL is never cached since every URL is issued, and audited, for one request.
+func (c *Client) ImpersonationURL(userID string, options organization.ImpersonationOptions) (*models.ImpersonateURL, error) {
+	return c.ImpersonationURLCtx(context.Background(), userID, options)
+}
+
+func (c *Client) ImpersonationURLCtx(ctx context.Context, userID string, options organization.ImpersonationOptions) (*models.ImpersonateURL, error) {
+	return c.next.ImpersonationURLCtx(ctx, userID, options)
+}
@@ -0,0 +1,77 @@
+package cache
+
+import (
+	"container/list"
+	"time"
+)
+
+// lru is a least recently used store of encoded values that expire.  It is not safe for concurrent use.
+type lru struct {
+	maxEntries int
+	order      *list.List
+	entries    map[string]*list.Element
+}
+
+type entry struct {
+	key     string
+	method  Method
+	value   []byte
+	expires time.Time
+	// organizationID is the organization the value belongs to, or 0 if it is not scoped to one organization.
+	organizationID int32
+}
+
+func newLRU(maxEntries int) *lru {
+	return &lru{maxEntries: maxEntries, order: list.New(), entries: make(map[string]*list.Element)}
+}
+
+// get returns the value stored under key if it has not expired at now, and marks it as recently used.
+func (l *lru) get(key string, now time.Time) ([]byte, bool) {
+	element, ok := l.entries[key]
+	if !ok {
+		return nil, false
+	}
+	e := element.Value.(*entry)
+	if !now.Before(e.expires) {
+		l.remove(element)
+		return nil, false
+	}
+	l.order.MoveToFront(element)
+	return e.value, true
+}
+
+// add stores e and returns the number of least recently used entries evicted to make room for it.
+func (l *lru) add(e *entry) int {
+	if element, ok := l.entries[e.key]; ok {
+		element.Value = e
+		l.order.MoveToFront(element)
+		return 0
+	}
+	l.entries[e.key] = l.order.PushFront(e)
+	evicted := 0
+	for l.order.Len() > l.maxEntries {
+		l.remove(l.order.Back())
+		evicted++
+	}
+	return evicted
+}
+
+// removeIf removes every entry for which match returns true.
+func (l *lru) removeIf(match func(*entry) bool) {
+	for element := l.order.Front(); element != nil; {
+		next := element.Next()
+		if match(element.Value.(*entry)) {
+			l.remove(element)
+		}
+		element = next
+	}
+}
+
+func (l *lru) remove(element *list.Element) {
+	l.order.Remove(element)
+	delete(l.entries, element.Value.(*entry).key)
+}
+
+func (l *lru) len() int {
+	return l.order.Len()
+}
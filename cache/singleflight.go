@@ -0,0 +1,83 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/3dsim/organization-goclient/organization"
+)
+
+// flightGroup de-duplicates concurrent calls with the same key so that only the first one runs and the others wait
+// for and share its result.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flight
+}
+
+type flight struct {
+	done     chan struct{}
+	value    []byte
+	err      error
+	panicked interface{}
+}
+
+// do runs fn unless a call with key is already running, in which case it waits for that call.  shared is true if the
+// result came from another call.
+//
+// fn runs in its own goroutine on a context that keeps the values of ctx but not its deadline or cancellation, so that
+// one caller giving up does not fail the others.  Every caller waits for the result only until its own ctx is done, in
+// which case it returns ctx.Err() and the call carries on for the remaining callers.
+//
+// If fn panics, the panic is logged to organization.Log and the waiting callers get an error.  The caller that started
+// the call panics again with the same value once the others have been released, unless it already gave up.
+func (g *flightGroup) do(ctx context.Context, key string, fn func(context.Context) ([]byte, error)) (value []byte, err error, shared bool) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*flight)
+	}
+	f, shared := g.calls[key]
+	if !shared {
+		f = &flight{done: make(chan struct{})}
+		g.calls[key] = f
+		go g.run(key, f, detachedContext{ctx}, fn)
+	}
+	g.mu.Unlock()
+
+	select {
+	case <-f.done:
+		if f.panicked != nil && !shared {
+			panic(f.panicked)
+		}
+		return f.value, f.err, shared
+	case <-ctx.Done():
+		return nil, ctx.Err(), shared
+	}
+}
+
+func (g *flightGroup) run(key string, f *flight, ctx context.Context, fn func(context.Context) ([]byte, error)) {
+	defer func() {
+		// A panic in this goroutine would crash the program, so it is handed to the caller that started the call.
+		if r := recover(); r != nil {
+			organization.Log.Error("Shared cache lookup panicked", "key", key, "panic", r, "stack", string(debug.Stack()))
+			f.value, f.err, f.panicked = nil, fmt.Errorf("cache: the shared lookup panicked: %v", r), r
+		}
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		close(f.done)
+	}()
+	f.value, f.err = fn(ctx)
+}
+
+// detachedContext is a context with the values of its parent that is never done.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool)         { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}               { return nil }
+func (detachedContext) Err() error                          { return nil }
+func (c detachedContext) Value(key interface{}) interface{} { return c.parent.Value(key) }
@@ -0,0 +1,162 @@
+// Package entitlements resolves what an organization may do from its organization record, its active subscriptions
+// and the plans of those subscriptions, so that every service applies the same rules.
+package entitlements
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/3dsim/organization-goclient/models"
+	"github.com/3dsim/organization-goclient/organization"
+)
+
+// Entitlements is the resolved set of limits, modules and features of an organization.  See Compute for the rules.
+type Entitlements struct {
+	OrganizationID int32
+	// RunningSimulationLimit is the number of simulations the organization may run at the same time.
+	RunningSimulationLimit int32
+	// ModuleIDs are the enabled modules, sorted ascending.
+	ModuleIDs []int32
+	// Features are the enabled features, sorted.
+	Features []string
+	// InTrial is true when the organization has active subscriptions and all of them are in their trial period.
+	InTrial bool
+	// TrialEnd is the latest trial end of the active subscriptions when InTrial is true, otherwise nil.
+	TrialEnd *time.Time
+	// Subscriptions are the active subscriptions the entitlements were resolved from, sorted by ID.
+	Subscriptions []*models.Subscription
+}
+
+// HasModule reports whether the module is enabled.
+func (e *Entitlements) HasModule(moduleID int32) bool {
+	i := sort.Search(len(e.ModuleIDs), func(i int) bool { return e.ModuleIDs[i] >= moduleID })
+	return i < len(e.ModuleIDs) && e.ModuleIDs[i] == moduleID
+}
+
+// HasFeature reports whether the feature is enabled.
+func (e *Entitlements) HasFeature(feature string) bool {
+	i := sort.SearchStrings(e.Features, feature)
+	return i < len(e.Features) && e.Features[i] == feature
+}
+
+// Resolver fetches the records needed to resolve the entitlements of an organization.
+type Resolver struct {
+	client organization.Client
+	now    func() time.Time
+}
+
+// NewResolver creates a Resolver that fetches from client.
+func NewResolver(client organization.Client) *Resolver {
+	return &Resolver{client: client, now: time.Now}
+}
+
+// Resolve fetches the organization, its subscriptions and the plan of every active subscription, and computes the
+// entitlements of the organization.  Each plan is fetched once.
+func (r *Resolver) Resolve(ctx context.Context, organizationID int32) (*Entitlements, error) {
+	org, err := r.client.OrganizationCtx(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	subscriptions, err := r.client.OrganizationSubscriptionsCtx(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	plans := make(map[int32]*models.Plan)
+	for _, subscription := range activeSubscriptions(subscriptions) {
+		if _, ok := plans[subscription.PlanID]; ok {
+			continue
+		}
+		plan, err := r.client.PlanCtx(ctx, subscription.PlanID)
+		if err != nil {
+			return nil, err
+		}
+		plans[subscription.PlanID] = plan
+	}
+	return Compute(org, subscriptions, plans, r.now()), nil
+}
+
+// Compute resolves the entitlements of org from its subscriptions and the plans of those subscriptions keyed by plan
+// ID.  Only active subscriptions count: Active is true and CanceledAt is not set.  When an organization has more than
+// one active subscription:
+//
+//   - RunningSimulationLimit is the organization's RunningSimulationLimit if it is greater than 0, which lets an
+//     organization be given a limit different from its plans.  Otherwise it is the highest limit of the plans; limits
+//     of different plans are not added together.  An organization without active subscriptions has a limit of 0.
+//   - ModuleIDs and Features are the union of those of the plans.
+//   - InTrial is true only when every active subscription has a TrialEnd after now, i.e. nothing has been paid for.
+//
+// Subscriptions whose plan is missing from plans contribute no limit, modules or features.
+func Compute(org *models.Organization, subscriptions []*models.Subscription, plans map[int32]*models.Plan, now time.Time) *Entitlements {
+	active := activeSubscriptions(subscriptions)
+	entitlements := &Entitlements{
+		OrganizationID: org.ID,
+		Subscriptions:  active,
+		ModuleIDs:      []int32{},
+		Features:       []string{},
+	}
+	if len(active) == 0 {
+		return entitlements
+	}
+
+	modules := make(map[int32]bool)
+	features := make(map[string]bool)
+	var planLimit int32
+	for _, subscription := range active {
+		plan, ok := plans[subscription.PlanID]
+		if !ok || plan == nil {
+			continue
+		}
+		if plan.RunningSimulationLimit != nil && *plan.RunningSimulationLimit > planLimit {
+			planLimit = *plan.RunningSimulationLimit
+		}
+		for _, module := range plan.PlanModules {
+			if module != nil {
+				modules[module.ModuleID] = true
+			}
+		}
+		for _, feature := range plan.Features {
+			features[feature] = true
+		}
+	}
+	entitlements.RunningSimulationLimit = planLimit
+	if org.RunningSimulationLimit != nil && *org.RunningSimulationLimit > 0 {
+		entitlements.RunningSimulationLimit = *org.RunningSimulationLimit
+	}
+	for moduleID := range modules {
+		entitlements.ModuleIDs = append(entitlements.ModuleIDs, moduleID)
+	}
+	sort.Slice(entitlements.ModuleIDs, func(i, j int) bool { return entitlements.ModuleIDs[i] < entitlements.ModuleIDs[j] })
+	for feature := range features {
+		entitlements.Features = append(entitlements.Features, feature)
+	}
+	sort.Strings(entitlements.Features)
+
+	entitlements.InTrial = true
+	var trialEnd time.Time
+	for _, subscription := range active {
+		if subscription.TrialEnd == nil || !time.Time(*subscription.TrialEnd).After(now) {
+			entitlements.InTrial = false
+			break
+		}
+		if end := time.Time(*subscription.TrialEnd); end.After(trialEnd) {
+			trialEnd = end
+		}
+	}
+	if entitlements.InTrial {
+		entitlements.TrialEnd = &trialEnd
+	}
+	return entitlements
+}
+
+// activeSubscriptions returns the subscriptions that are active and not canceled, sorted by ID.
+func activeSubscriptions(subscriptions []*models.Subscription) []*models.Subscription {
+	active := []*models.Subscription{}
+	for _, subscription := range subscriptions {
+		if subscription != nil && subscription.Active && subscription.CanceledAt == nil {
+			active = append(active, subscription)
+		}
+	}
+	sort.Slice(active, func(i, j int) bool { return active[i].ID < active[j].ID })
+	return active
+}
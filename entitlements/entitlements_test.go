@@ -0,0 +1,194 @@
+package entitlements
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/3dsim/organization-goclient/models"
+	"github.com/3dsim/organization-goclient/organization/organizationfakes"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+)
+
+var now = time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func dateTime(t time.Time) *strfmt.DateTime {
+	d := strfmt.DateTime(t)
+	return &d
+}
+
+func plan(id, limit int32, features []string, moduleIDs ...int32) *models.Plan {
+	p := &models.Plan{
+		ID:                     id,
+		Name:                   swag.String("Plan"),
+		RunningSimulationLimit: swag.Int32(limit),
+		TrialPeriodDays:        swag.Int32(14),
+		Features:               features,
+	}
+	for _, moduleID := range moduleIDs {
+		p.PlanModules = append(p.PlanModules, &models.PlanModule{ModuleID: moduleID, PlanID: id})
+	}
+	return p
+}
+
+func TestComputeWhenMultipleActiveSubscriptionsExpectsDeterministicUnion(t *testing.T) {
+	// arrange
+	org := &models.Organization{ID: 1, RunningSimulationLimit: swag.Int32(0)}
+	subscriptions := []*models.Subscription{
+		{ID: 3, OrganizationID: 1, PlanID: 20, Active: true},
+		{ID: 1, OrganizationID: 1, PlanID: 10, Active: true},
+		{ID: 2, OrganizationID: 1, PlanID: 30, Active: true, CanceledAt: dateTime(now.Add(-time.Hour))},
+		{ID: 4, OrganizationID: 1, PlanID: 30},
+	}
+	plans := map[int32]*models.Plan{
+		10: plan(10, 2, []string{"print", "export"}, 5, 1),
+		20: plan(20, 4, []string{"export", "api"}, 1, 3),
+		30: plan(30, 100, []string{"everything"}, 99),
+	}
+
+	// act
+	entitlements := Compute(org, subscriptions, plans, now)
+
+	// assert
+	assert.Equal(t, int32(1), entitlements.OrganizationID, "Expected organization ids to match")
+	assert.Equal(t, int32(4), entitlements.RunningSimulationLimit, "Expected the highest plan limit, not the sum")
+	assert.Equal(t, []int32{1, 3, 5}, entitlements.ModuleIDs, "Expected the sorted union of the modules")
+	assert.Equal(t, []string{"api", "export", "print"}, entitlements.Features, "Expected the sorted union of the features")
+	assert.False(t, entitlements.InTrial, "Expected no trial")
+	assert.Nil(t, entitlements.TrialEnd, "Expected no trial end")
+	if assert.Len(t, entitlements.Subscriptions, 2, "Expected only the active subscriptions") {
+		assert.Equal(t, int32(1), entitlements.Subscriptions[0].ID, "Expected subscriptions sorted by id")
+		assert.Equal(t, int32(3), entitlements.Subscriptions[1].ID, "Expected subscriptions sorted by id")
+	}
+	assert.True(t, entitlements.HasModule(3), "Expected module 3 to be enabled")
+	assert.False(t, entitlements.HasModule(99), "Expected the module of a canceled subscription not to be enabled")
+	assert.True(t, entitlements.HasFeature("api"), "Expected feature api to be enabled")
+	assert.False(t, entitlements.HasFeature("everything"), "Expected the feature of an inactive subscription not to be enabled")
+}
+
+func TestComputeWhenOrganizationLimitSetExpectsOrganizationLimitUsed(t *testing.T) {
+	// arrange
+	org := &models.Organization{ID: 1, RunningSimulationLimit: swag.Int32(10)}
+	subscriptions := []*models.Subscription{{ID: 1, PlanID: 10, Active: true}}
+	plans := map[int32]*models.Plan{10: plan(10, 2, nil)}
+
+	// act
+	entitlements := Compute(org, subscriptions, plans, now)
+
+	// assert
+	assert.Equal(t, int32(10), entitlements.RunningSimulationLimit, "Expected the organization limit to override the plan")
+}
+
+func TestComputeWhenNoActiveSubscriptionsExpectsNothingEnabled(t *testing.T) {
+	// arrange
+	org := &models.Organization{ID: 1, RunningSimulationLimit: swag.Int32(10)}
+	subscriptions := []*models.Subscription{{ID: 1, PlanID: 10}}
+	plans := map[int32]*models.Plan{10: plan(10, 2, []string{"print"}, 1)}
+
+	// act
+	entitlements := Compute(org, subscriptions, plans, now)
+
+	// assert
+	assert.Equal(t, int32(0), entitlements.RunningSimulationLimit, "Expected no simulations allowed")
+	assert.Empty(t, entitlements.ModuleIDs, "Expected no modules")
+	assert.Empty(t, entitlements.Features, "Expected no features")
+	assert.False(t, entitlements.InTrial, "Expected no trial")
+}
+
+func TestComputeTrialStatus(t *testing.T) {
+	testCases := []struct {
+		name             string
+		trialEnds        []*strfmt.DateTime
+		expectedInTrial  bool
+		expectedTrialEnd *time.Time
+	}{
+		{"no trial", []*strfmt.DateTime{nil}, false, nil},
+		{"trial running", []*strfmt.DateTime{dateTime(now.Add(48 * time.Hour))}, true, swag.Time(now.Add(48 * time.Hour))},
+		{"trial ended", []*strfmt.DateTime{dateTime(now.Add(-time.Hour))}, false, nil},
+		{"all in trial uses latest end", []*strfmt.DateTime{dateTime(now.Add(time.Hour)), dateTime(now.Add(72 * time.Hour))}, true, swag.Time(now.Add(72 * time.Hour))},
+		{"one paid subscription", []*strfmt.DateTime{dateTime(now.Add(time.Hour)), nil}, false, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// arrange
+			org := &models.Organization{ID: 1}
+			var subscriptions []*models.Subscription
+			for i, trialEnd := range tc.trialEnds {
+				subscriptions = append(subscriptions, &models.Subscription{ID: int32(i), PlanID: 10, Active: true, TrialEnd: trialEnd})
+			}
+			plans := map[int32]*models.Plan{10: plan(10, 2, nil)}
+
+			// act
+			entitlements := Compute(org, subscriptions, plans, now)
+
+			// assert
+			assert.Equal(t, tc.expectedInTrial, entitlements.InTrial, "Expected trial status to match")
+			assert.Equal(t, tc.expectedTrialEnd, entitlements.TrialEnd, "Expected trial ends to match")
+		})
+	}
+}
+
+func TestResolveWhenSuccessfulExpectsEachPlanFetchedOnce(t *testing.T) {
+	// arrange
+	fakeClient := &organizationfakes.FakeClient{}
+	fakeClient.OrganizationCtxReturns(&models.Organization{ID: 1}, nil)
+	fakeClient.OrganizationSubscriptionsCtxReturns([]*models.Subscription{
+		{ID: 1, OrganizationID: 1, PlanID: 10, Active: true},
+		{ID: 2, OrganizationID: 1, PlanID: 10, Active: true},
+		{ID: 3, OrganizationID: 1, PlanID: 20},
+	}, nil)
+	fakeClient.PlanCtxReturns(plan(10, 3, []string{"print"}, 7), nil)
+	resolver := NewResolver(fakeClient)
+	resolver.now = func() time.Time { return now }
+
+	// act
+	entitlements, err := resolver.Resolve(context.Background(), 1)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	assert.Equal(t, int32(3), entitlements.RunningSimulationLimit, "Expected the plan limit")
+	assert.Equal(t, []int32{7}, entitlements.ModuleIDs, "Expected the plan modules")
+	assert.Equal(t, 1, fakeClient.PlanCtxCallCount(), "Expected the shared plan to be fetched once and the inactive one not at all")
+	_, organizationID := fakeClient.OrganizationSubscriptionsCtxArgsForCall(0)
+	assert.Equal(t, int32(1), organizationID, "Expected the subscriptions of the organization to be fetched")
+}
+
+func TestResolveWhenClientErrorsExpectsErrorReturned(t *testing.T) {
+	expectedError := errors.New("Some organization api error")
+	testCases := []struct {
+		name  string
+		setup func(*organizationfakes.FakeClient)
+	}{
+		{"organization", func(f *organizationfakes.FakeClient) {
+			f.OrganizationCtxReturns(nil, expectedError)
+		}},
+		{"subscriptions", func(f *organizationfakes.FakeClient) {
+			f.OrganizationCtxReturns(&models.Organization{ID: 1}, nil)
+			f.OrganizationSubscriptionsCtxReturns(nil, expectedError)
+		}},
+		{"plan", func(f *organizationfakes.FakeClient) {
+			f.OrganizationCtxReturns(&models.Organization{ID: 1}, nil)
+			f.OrganizationSubscriptionsCtxReturns([]*models.Subscription{{ID: 1, PlanID: 10, Active: true}}, nil)
+			f.PlanCtxReturns(nil, expectedError)
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// arrange
+			fakeClient := &organizationfakes.FakeClient{}
+			tc.setup(fakeClient)
+
+			// act
+			entitlements, err := NewResolver(fakeClient).Resolve(context.Background(), 1)
+
+			// assert
+			assert.Equal(t, expectedError, err, "Expected the client error returned")
+			assert.Nil(t, entitlements, "Expected no entitlements returned")
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package operations
+
+// This file is not generated.  POST /organizations is not in the organization api swagger specification yet, so it is
+// written by hand in the form go-swagger generates.  The path and the responses, 200, 400, 401 and 403, are the ones
+// the api is expected to serve, not ones read from the specification.  Delete this file once the client is regenerated
+// from a specification that defines the operation, see README.md.
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/3dsim/organization-goclient/models"
+)
+
+// NewAddOrganizationParams creates a new AddOrganizationParams object
+// with the default values initialized.
+func NewAddOrganizationParams() *AddOrganizationParams {
+	var ()
+	return &AddOrganizationParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAddOrganizationParamsWithTimeout creates a new AddOrganizationParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewAddOrganizationParamsWithTimeout(timeout time.Duration) *AddOrganizationParams {
+	var ()
+	return &AddOrganizationParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewAddOrganizationParamsWithContext creates a new AddOrganizationParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewAddOrganizationParamsWithContext(ctx context.Context) *AddOrganizationParams {
+	var ()
+	return &AddOrganizationParams{
+
+		Context: ctx,
+	}
+}
+
+// NewAddOrganizationParamsWithHTTPClient creates a new AddOrganizationParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewAddOrganizationParamsWithHTTPClient(client *http.Client) *AddOrganizationParams {
+	var ()
+	return &AddOrganizationParams{
+		HTTPClient: client,
+	}
+}
+
+/*AddOrganizationParams contains all the parameters to send to the API endpoint
+for the add organization operation typically these are written to a http.Request
+*/
+type AddOrganizationParams struct {
+
+	/*Organization*/
+	Organization *models.Organization
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the add organization params
+func (o *AddOrganizationParams) WithTimeout(timeout time.Duration) *AddOrganizationParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the add organization params
+func (o *AddOrganizationParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the add organization params
+func (o *AddOrganizationParams) WithContext(ctx context.Context) *AddOrganizationParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the add organization params
+func (o *AddOrganizationParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the add organization params
+func (o *AddOrganizationParams) WithHTTPClient(client *http.Client) *AddOrganizationParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the add organization params
+func (o *AddOrganizationParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithOrganization adds the organization to the add organization params
+func (o *AddOrganizationParams) WithOrganization(organization *models.Organization) *AddOrganizationParams {
+	o.SetOrganization(organization)
+	return o
+}
+
+// SetOrganization adds the organization to the add organization params
+func (o *AddOrganizationParams) SetOrganization(organization *models.Organization) {
+	o.Organization = organization
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AddOrganizationParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Organization == nil {
+		o.Organization = new(models.Organization)
+	}
+
+	if err := r.SetBodyParam(o.Organization); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
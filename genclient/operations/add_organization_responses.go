@@ -0,0 +1,187 @@
+package operations
+
+// This file is not generated.  POST /organizations is not in the organization api swagger specification yet, so it is
+// written by hand in the form go-swagger generates.  The path and the responses, 200, 400, 401 and 403, are the ones
+// the api is expected to serve, not ones read from the specification.  Delete this file once the client is regenerated
+// from a specification that defines the operation, see README.md.
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/3dsim/organization-goclient/models"
+)
+
+// AddOrganizationReader is a Reader for the AddOrganization structure.
+type AddOrganizationReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AddOrganizationReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewAddOrganizationOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 400:
+		result := NewAddOrganizationBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 401:
+		result := NewAddOrganizationUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 403:
+		result := NewAddOrganizationForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		result := NewAddOrganizationDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewAddOrganizationOK creates a AddOrganizationOK with default headers values
+func NewAddOrganizationOK() *AddOrganizationOK {
+	return &AddOrganizationOK{}
+}
+
+/*AddOrganizationOK handles this case with default header values.
+
+Successfully created organization
+*/
+type AddOrganizationOK struct {
+	Payload *models.Organization
+}
+
+func (o *AddOrganizationOK) Error() string {
+	return fmt.Sprintf("[POST /organizations][%d] addOrganizationOK  %+v", 200, o.Payload)
+}
+
+func (o *AddOrganizationOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Organization)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAddOrganizationBadRequest creates a AddOrganizationBadRequest with default headers values
+func NewAddOrganizationBadRequest() *AddOrganizationBadRequest {
+	return &AddOrganizationBadRequest{}
+}
+
+/*AddOrganizationBadRequest handles this case with default header values.
+
+Invalid organization
+*/
+type AddOrganizationBadRequest struct {
+}
+
+func (o *AddOrganizationBadRequest) Error() string {
+	return fmt.Sprintf("[POST /organizations][%d] addOrganizationBadRequest ", 400)
+}
+
+func (o *AddOrganizationBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAddOrganizationUnauthorized creates a AddOrganizationUnauthorized with default headers values
+func NewAddOrganizationUnauthorized() *AddOrganizationUnauthorized {
+	return &AddOrganizationUnauthorized{}
+}
+
+/*AddOrganizationUnauthorized handles this case with default header values.
+
+Not authorized
+*/
+type AddOrganizationUnauthorized struct {
+}
+
+func (o *AddOrganizationUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /organizations][%d] addOrganizationUnauthorized ", 401)
+}
+
+func (o *AddOrganizationUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAddOrganizationForbidden creates a AddOrganizationForbidden with default headers values
+func NewAddOrganizationForbidden() *AddOrganizationForbidden {
+	return &AddOrganizationForbidden{}
+}
+
+/*AddOrganizationForbidden handles this case with default header values.
+
+Forbidden
+*/
+type AddOrganizationForbidden struct {
+}
+
+func (o *AddOrganizationForbidden) Error() string {
+	return fmt.Sprintf("[POST /organizations][%d] addOrganizationForbidden ", 403)
+}
+
+func (o *AddOrganizationForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAddOrganizationDefault creates a AddOrganizationDefault with default headers values
+func NewAddOrganizationDefault(code int) *AddOrganizationDefault {
+	return &AddOrganizationDefault{
+		_statusCode: code,
+	}
+}
+
+/*AddOrganizationDefault handles this case with default header values.
+
+unexpected error
+*/
+type AddOrganizationDefault struct {
+	_statusCode int
+}
+
+// Code gets the status code for the add organization default response
+func (o *AddOrganizationDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *AddOrganizationDefault) Error() string {
+	return fmt.Sprintf("[POST /organizations][%d] addOrganization default ", o._statusCode)
+}
+
+func (o *AddOrganizationDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package operations
+
+// This file is not generated.  POST /organizations/{orgId}/subscriptions is not in the organization api swagger
+// specification yet, so it is written by hand in the form go-swagger generates.  The path and the responses, 200, 400,
+// 401, 403 and 404, are the ones the api is expected to serve, not ones read from the specification.  Delete this file
+// once the client is regenerated from a specification that defines the operation, see README.md.
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/3dsim/organization-goclient/models"
+)
+
+// AddSubscriptionReader is a Reader for the AddSubscription structure.
+type AddSubscriptionReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AddSubscriptionReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewAddSubscriptionOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 400:
+		result := NewAddSubscriptionBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 401:
+		result := NewAddSubscriptionUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 403:
+		result := NewAddSubscriptionForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 404:
+		result := NewAddSubscriptionNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		result := NewAddSubscriptionDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewAddSubscriptionOK creates a AddSubscriptionOK with default headers values
+func NewAddSubscriptionOK() *AddSubscriptionOK {
+	return &AddSubscriptionOK{}
+}
+
+/*AddSubscriptionOK handles this case with default header values.
+
+Successfully created subscription
+*/
+type AddSubscriptionOK struct {
+	Payload *models.Subscription
+}
+
+func (o *AddSubscriptionOK) Error() string {
+	return fmt.Sprintf("[POST /organizations/{orgId}/subscriptions][%d] addSubscriptionOK  %+v", 200, o.Payload)
+}
+
+func (o *AddSubscriptionOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Subscription)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAddSubscriptionBadRequest creates a AddSubscriptionBadRequest with default headers values
+func NewAddSubscriptionBadRequest() *AddSubscriptionBadRequest {
+	return &AddSubscriptionBadRequest{}
+}
+
+/*AddSubscriptionBadRequest handles this case with default header values.
+
+Invalid subscription
+*/
+type AddSubscriptionBadRequest struct {
+}
+
+func (o *AddSubscriptionBadRequest) Error() string {
+	return fmt.Sprintf("[POST /organizations/{orgId}/subscriptions][%d] addSubscriptionBadRequest ", 400)
+}
+
+func (o *AddSubscriptionBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAddSubscriptionUnauthorized creates a AddSubscriptionUnauthorized with default headers values
+func NewAddSubscriptionUnauthorized() *AddSubscriptionUnauthorized {
+	return &AddSubscriptionUnauthorized{}
+}
+
+/*AddSubscriptionUnauthorized handles this case with default header values.
+
+Not authorized
+*/
+type AddSubscriptionUnauthorized struct {
+}
+
+func (o *AddSubscriptionUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /organizations/{orgId}/subscriptions][%d] addSubscriptionUnauthorized ", 401)
+}
+
+func (o *AddSubscriptionUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAddSubscriptionForbidden creates a AddSubscriptionForbidden with default headers values
+func NewAddSubscriptionForbidden() *AddSubscriptionForbidden {
+	return &AddSubscriptionForbidden{}
+}
+
+/*AddSubscriptionForbidden handles this case with default header values.
+
+Forbidden
+*/
+type AddSubscriptionForbidden struct {
+}
+
+func (o *AddSubscriptionForbidden) Error() string {
+	return fmt.Sprintf("[POST /organizations/{orgId}/subscriptions][%d] addSubscriptionForbidden ", 403)
+}
+
+func (o *AddSubscriptionForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAddSubscriptionNotFound creates a AddSubscriptionNotFound with default headers values
+func NewAddSubscriptionNotFound() *AddSubscriptionNotFound {
+	return &AddSubscriptionNotFound{}
+}
+
+/*AddSubscriptionNotFound handles this case with default header values.
+
+Organization not found
+*/
+type AddSubscriptionNotFound struct {
+}
+
+func (o *AddSubscriptionNotFound) Error() string {
+	return fmt.Sprintf("[POST /organizations/{orgId}/subscriptions][%d] addSubscriptionNotFound ", 404)
+}
+
+func (o *AddSubscriptionNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAddSubscriptionDefault creates a AddSubscriptionDefault with default headers values
+func NewAddSubscriptionDefault(code int) *AddSubscriptionDefault {
+	return &AddSubscriptionDefault{
+		_statusCode: code,
+	}
+}
+
+/*AddSubscriptionDefault handles this case with default header values.
+
+unexpected error
+*/
+type AddSubscriptionDefault struct {
+	_statusCode int
+}
+
+// Code gets the status code for the add subscription default response
+func (o *AddSubscriptionDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *AddSubscriptionDefault) Error() string {
+	return fmt.Sprintf("[POST /organizations/{orgId}/subscriptions][%d] addSubscription default ", o._statusCode)
+}
+
+func (o *AddSubscriptionDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
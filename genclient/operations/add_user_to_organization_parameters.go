@@ -0,0 +1,161 @@
+package operations
+
+// This file is not generated.  POST /organizations/{id}/users is not in the organization api swagger specification yet,
+// so it is written by hand in the form go-swagger generates.  The path and the responses, 200, 400, 401, 403 and 409
+// for a duplicate email, are the ones the api is expected to serve, not ones read from the specification.  Delete this
+// file once the client is regenerated from a specification that defines the operation, see README.md.
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/3dsim/organization-goclient/models"
+)
+
+// NewAddUserToOrganizationParams creates a new AddUserToOrganizationParams object
+// with the default values initialized.
+func NewAddUserToOrganizationParams() *AddUserToOrganizationParams {
+	var ()
+	return &AddUserToOrganizationParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAddUserToOrganizationParamsWithTimeout creates a new AddUserToOrganizationParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewAddUserToOrganizationParamsWithTimeout(timeout time.Duration) *AddUserToOrganizationParams {
+	var ()
+	return &AddUserToOrganizationParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewAddUserToOrganizationParamsWithContext creates a new AddUserToOrganizationParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewAddUserToOrganizationParamsWithContext(ctx context.Context) *AddUserToOrganizationParams {
+	var ()
+	return &AddUserToOrganizationParams{
+
+		Context: ctx,
+	}
+}
+
+// NewAddUserToOrganizationParamsWithHTTPClient creates a new AddUserToOrganizationParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewAddUserToOrganizationParamsWithHTTPClient(client *http.Client) *AddUserToOrganizationParams {
+	var ()
+	return &AddUserToOrganizationParams{
+		HTTPClient: client,
+	}
+}
+
+/*AddUserToOrganizationParams contains all the parameters to send to the API endpoint
+for the add user to organization operation typically these are written to a http.Request
+*/
+type AddUserToOrganizationParams struct {
+
+	/*ID
+	  ID of organization
+
+	*/
+	ID int32
+	/*User*/
+	User *models.UserPost
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the add user to organization params
+func (o *AddUserToOrganizationParams) WithTimeout(timeout time.Duration) *AddUserToOrganizationParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the add user to organization params
+func (o *AddUserToOrganizationParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the add user to organization params
+func (o *AddUserToOrganizationParams) WithContext(ctx context.Context) *AddUserToOrganizationParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the add user to organization params
+func (o *AddUserToOrganizationParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the add user to organization params
+func (o *AddUserToOrganizationParams) WithHTTPClient(client *http.Client) *AddUserToOrganizationParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the add user to organization params
+func (o *AddUserToOrganizationParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the add user to organization params
+func (o *AddUserToOrganizationParams) WithID(id int32) *AddUserToOrganizationParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the add user to organization params
+func (o *AddUserToOrganizationParams) SetID(id int32) {
+	o.ID = id
+}
+
+// WithUser adds the user to the add user to organization params
+func (o *AddUserToOrganizationParams) WithUser(user *models.UserPost) *AddUserToOrganizationParams {
+	o.SetUser(user)
+	return o
+}
+
+// SetUser adds the user to the add user to organization params
+func (o *AddUserToOrganizationParams) SetUser(user *models.UserPost) {
+	o.User = user
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AddUserToOrganizationParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", swag.FormatInt32(o.ID)); err != nil {
+		return err
+	}
+
+	if o.User == nil {
+		o.User = new(models.UserPost)
+	}
+
+	if err := r.SetBodyParam(o.User); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+package operations
+
+// This file is not generated.  POST /organizations/{id}/users is not in the organization api swagger specification yet,
+// so it is written by hand in the form go-swagger generates.  The path and the responses, 200, 400, 401, 403 and 409
+// for a duplicate email, are the ones the api is expected to serve, not ones read from the specification.  Delete this
+// file once the client is regenerated from a specification that defines the operation, see README.md.
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/3dsim/organization-goclient/models"
+)
+
+// AddUserToOrganizationReader is a Reader for the AddUserToOrganization structure.
+type AddUserToOrganizationReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AddUserToOrganizationReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewAddUserToOrganizationOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 400:
+		result := NewAddUserToOrganizationBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 401:
+		result := NewAddUserToOrganizationUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 403:
+		result := NewAddUserToOrganizationForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 409:
+		result := NewAddUserToOrganizationConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		result := NewAddUserToOrganizationDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewAddUserToOrganizationOK creates a AddUserToOrganizationOK with default headers values
+func NewAddUserToOrganizationOK() *AddUserToOrganizationOK {
+	return &AddUserToOrganizationOK{}
+}
+
+/*AddUserToOrganizationOK handles this case with default header values.
+
+Successfully created user
+*/
+type AddUserToOrganizationOK struct {
+	Payload *models.User
+}
+
+func (o *AddUserToOrganizationOK) Error() string {
+	return fmt.Sprintf("[POST /organizations/{id}/users][%d] addUserToOrganizationOK  %+v", 200, o.Payload)
+}
+
+func (o *AddUserToOrganizationOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.User)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAddUserToOrganizationBadRequest creates a AddUserToOrganizationBadRequest with default headers values
+func NewAddUserToOrganizationBadRequest() *AddUserToOrganizationBadRequest {
+	return &AddUserToOrganizationBadRequest{}
+}
+
+/*AddUserToOrganizationBadRequest handles this case with default header values.
+
+Invalid user
+*/
+type AddUserToOrganizationBadRequest struct {
+}
+
+func (o *AddUserToOrganizationBadRequest) Error() string {
+	return fmt.Sprintf("[POST /organizations/{id}/users][%d] addUserToOrganizationBadRequest ", 400)
+}
+
+func (o *AddUserToOrganizationBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAddUserToOrganizationUnauthorized creates a AddUserToOrganizationUnauthorized with default headers values
+func NewAddUserToOrganizationUnauthorized() *AddUserToOrganizationUnauthorized {
+	return &AddUserToOrganizationUnauthorized{}
+}
+
+/*AddUserToOrganizationUnauthorized handles this case with default header values.
+
+Not authorized
+*/
+type AddUserToOrganizationUnauthorized struct {
+}
+
+func (o *AddUserToOrganizationUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /organizations/{id}/users][%d] addUserToOrganizationUnauthorized ", 401)
+}
+
+func (o *AddUserToOrganizationUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAddUserToOrganizationForbidden creates a AddUserToOrganizationForbidden with default headers values
+func NewAddUserToOrganizationForbidden() *AddUserToOrganizationForbidden {
+	return &AddUserToOrganizationForbidden{}
+}
+
+/*AddUserToOrganizationForbidden handles this case with default header values.
+
+Forbidden
+*/
+type AddUserToOrganizationForbidden struct {
+}
+
+func (o *AddUserToOrganizationForbidden) Error() string {
+	return fmt.Sprintf("[POST /organizations/{id}/users][%d] addUserToOrganizationForbidden ", 403)
+}
+
+func (o *AddUserToOrganizationForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAddUserToOrganizationConflict creates a AddUserToOrganizationConflict with default headers values
+func NewAddUserToOrganizationConflict() *AddUserToOrganizationConflict {
+	return &AddUserToOrganizationConflict{}
+}
+
+/*AddUserToOrganizationConflict handles this case with default header values.
+
+A user with the email already exists
+*/
+type AddUserToOrganizationConflict struct {
+}
+
+func (o *AddUserToOrganizationConflict) Error() string {
+	return fmt.Sprintf("[POST /organizations/{id}/users][%d] addUserToOrganizationConflict ", 409)
+}
+
+func (o *AddUserToOrganizationConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAddUserToOrganizationDefault creates a AddUserToOrganizationDefault with default headers values
+func NewAddUserToOrganizationDefault(code int) *AddUserToOrganizationDefault {
+	return &AddUserToOrganizationDefault{
+		_statusCode: code,
+	}
+}
+
+/*AddUserToOrganizationDefault handles this case with default header values.
+
+unexpected error
+*/
+type AddUserToOrganizationDefault struct {
+	_statusCode int
+}
+
+// Code gets the status code for the add user to organization default response
+func (o *AddUserToOrganizationDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *AddUserToOrganizationDefault) Error() string {
+	return fmt.Sprintf("[POST /organizations/{id}/users][%d] addUserToOrganization default ", o._statusCode)
+}
+
+func (o *AddUserToOrganizationDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
@@ -0,0 +1,190 @@
+package operations
+
+// This file is not generated.  PUT /organizations/{orgId}/subscriptions/{subId} is in the organization api swagger
+// specification, but models.Subscription omits active when it is false, so the generated putSubscription cannot ask
+// the api to deactivate a subscription.  This operation sends the same request with "active": false set explicitly.
+// Keep this file when regenerating the client, see README.md.
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/3dsim/organization-goclient/models"
+)
+
+// NewCancelSubscriptionParams creates a new CancelSubscriptionParams object
+// with the default values initialized.
+func NewCancelSubscriptionParams() *CancelSubscriptionParams {
+	var ()
+	return &CancelSubscriptionParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCancelSubscriptionParamsWithTimeout creates a new CancelSubscriptionParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewCancelSubscriptionParamsWithTimeout(timeout time.Duration) *CancelSubscriptionParams {
+	var ()
+	return &CancelSubscriptionParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewCancelSubscriptionParamsWithContext creates a new CancelSubscriptionParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewCancelSubscriptionParamsWithContext(ctx context.Context) *CancelSubscriptionParams {
+	var ()
+	return &CancelSubscriptionParams{
+
+		Context: ctx,
+	}
+}
+
+// NewCancelSubscriptionParamsWithHTTPClient creates a new CancelSubscriptionParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewCancelSubscriptionParamsWithHTTPClient(client *http.Client) *CancelSubscriptionParams {
+	var ()
+	return &CancelSubscriptionParams{
+		HTTPClient: client,
+	}
+}
+
+/*CancelSubscriptionParams contains all the parameters to send to the API endpoint
+for the cancel subscription operation typically these are written to a http.Request
+*/
+type CancelSubscriptionParams struct {
+
+	/*OrgID
+	  organization id
+
+	*/
+	OrgID int32
+	/*SubID
+	  subscription id
+
+	*/
+	SubID int32
+	/*Subscription*/
+	Subscription *models.Subscription
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the cancel subscription params
+func (o *CancelSubscriptionParams) WithTimeout(timeout time.Duration) *CancelSubscriptionParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the cancel subscription params
+func (o *CancelSubscriptionParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the cancel subscription params
+func (o *CancelSubscriptionParams) WithContext(ctx context.Context) *CancelSubscriptionParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the cancel subscription params
+func (o *CancelSubscriptionParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the cancel subscription params
+func (o *CancelSubscriptionParams) WithHTTPClient(client *http.Client) *CancelSubscriptionParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the cancel subscription params
+func (o *CancelSubscriptionParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithOrgID adds the orgID to the cancel subscription params
+func (o *CancelSubscriptionParams) WithOrgID(orgID int32) *CancelSubscriptionParams {
+	o.SetOrgID(orgID)
+	return o
+}
+
+// SetOrgID adds the orgId to the cancel subscription params
+func (o *CancelSubscriptionParams) SetOrgID(orgID int32) {
+	o.OrgID = orgID
+}
+
+// WithSubID adds the subID to the cancel subscription params
+func (o *CancelSubscriptionParams) WithSubID(subID int32) *CancelSubscriptionParams {
+	o.SetSubID(subID)
+	return o
+}
+
+// SetSubID adds the subId to the cancel subscription params
+func (o *CancelSubscriptionParams) SetSubID(subID int32) {
+	o.SubID = subID
+}
+
+// WithSubscription adds the subscription to the cancel subscription params
+func (o *CancelSubscriptionParams) WithSubscription(subscription *models.Subscription) *CancelSubscriptionParams {
+	o.SetSubscription(subscription)
+	return o
+}
+
+// SetSubscription adds the subscription to the cancel subscription params
+func (o *CancelSubscriptionParams) SetSubscription(subscription *models.Subscription) {
+	o.Subscription = subscription
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CancelSubscriptionParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param orgId
+	if err := r.SetPathParam("orgId", swag.FormatInt32(o.OrgID)); err != nil {
+		return err
+	}
+
+	// path param subId
+	if err := r.SetPathParam("subId", swag.FormatInt32(o.SubID)); err != nil {
+		return err
+	}
+
+	if o.Subscription == nil {
+		o.Subscription = new(models.Subscription)
+	}
+
+	if err := r.SetBodyParam(canceledSubscription{Subscription: o.Subscription}); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// canceledSubscription is the body of the cancel subscription operation.  Its active field hides the one of the
+// embedded subscription, which is omitted when false.
+type canceledSubscription struct {
+	*models.Subscription
+
+	Active bool `json:"active"`
+}
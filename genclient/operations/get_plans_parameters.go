@@ -0,0 +1,326 @@
+package operations
+
+// This file is not generated.  GET /plans is not in the organization api swagger specification yet, so it is written by
+// hand in the form go-swagger generates.  The path and the responses, 200, 401 and 403, are the ones the api is
+// expected to serve, not ones read from the specification.  Delete this file once the client is regenerated from a
+// specification that defines the operation, see README.md.
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetPlansParams creates a new GetPlansParams object
+// with the default values initialized.
+func NewGetPlansParams() *GetPlansParams {
+	var ()
+	return &GetPlansParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetPlansParamsWithTimeout creates a new GetPlansParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetPlansParamsWithTimeout(timeout time.Duration) *GetPlansParams {
+	var ()
+	return &GetPlansParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetPlansParamsWithContext creates a new GetPlansParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetPlansParamsWithContext(ctx context.Context) *GetPlansParams {
+	var ()
+	return &GetPlansParams{
+
+		Context: ctx,
+	}
+}
+
+// NewGetPlansParamsWithHTTPClient creates a new GetPlansParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetPlansParamsWithHTTPClient(client *http.Client) *GetPlansParams {
+	var ()
+	return &GetPlansParams{
+		HTTPClient: client,
+	}
+}
+
+/*GetPlansParams contains all the parameters to send to the API endpoint
+for the get plans operation typically these are written to a http.Request
+*/
+type GetPlansParams struct {
+
+	/*AllowWebSignup*/
+	AllowWebSignup *bool
+	/*Available*/
+	Available *bool
+	/*BillingInterval*/
+	BillingInterval *string
+	/*Limit
+	  number of items to return within the query
+
+	*/
+	Limit *int32
+	/*Offset
+	  starting paging count; ex. 60 will skip the first 60 items in the list
+
+	*/
+	Offset *int32
+	/*PaymentMethod*/
+	PaymentMethod *string
+	/*PlanGroup*/
+	PlanGroup *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get plans params
+func (o *GetPlansParams) WithTimeout(timeout time.Duration) *GetPlansParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get plans params
+func (o *GetPlansParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get plans params
+func (o *GetPlansParams) WithContext(ctx context.Context) *GetPlansParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get plans params
+func (o *GetPlansParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get plans params
+func (o *GetPlansParams) WithHTTPClient(client *http.Client) *GetPlansParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get plans params
+func (o *GetPlansParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAllowWebSignup adds the allowWebSignup to the get plans params
+func (o *GetPlansParams) WithAllowWebSignup(allowWebSignup *bool) *GetPlansParams {
+	o.SetAllowWebSignup(allowWebSignup)
+	return o
+}
+
+// SetAllowWebSignup adds the allowWebSignup to the get plans params
+func (o *GetPlansParams) SetAllowWebSignup(allowWebSignup *bool) {
+	o.AllowWebSignup = allowWebSignup
+}
+
+// WithAvailable adds the available to the get plans params
+func (o *GetPlansParams) WithAvailable(available *bool) *GetPlansParams {
+	o.SetAvailable(available)
+	return o
+}
+
+// SetAvailable adds the available to the get plans params
+func (o *GetPlansParams) SetAvailable(available *bool) {
+	o.Available = available
+}
+
+// WithBillingInterval adds the billingInterval to the get plans params
+func (o *GetPlansParams) WithBillingInterval(billingInterval *string) *GetPlansParams {
+	o.SetBillingInterval(billingInterval)
+	return o
+}
+
+// SetBillingInterval adds the billingInterval to the get plans params
+func (o *GetPlansParams) SetBillingInterval(billingInterval *string) {
+	o.BillingInterval = billingInterval
+}
+
+// WithLimit adds the limit to the get plans params
+func (o *GetPlansParams) WithLimit(limit *int32) *GetPlansParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the get plans params
+func (o *GetPlansParams) SetLimit(limit *int32) {
+	o.Limit = limit
+}
+
+// WithOffset adds the offset to the get plans params
+func (o *GetPlansParams) WithOffset(offset *int32) *GetPlansParams {
+	o.SetOffset(offset)
+	return o
+}
+
+// SetOffset adds the offset to the get plans params
+func (o *GetPlansParams) SetOffset(offset *int32) {
+	o.Offset = offset
+}
+
+// WithPaymentMethod adds the paymentMethod to the get plans params
+func (o *GetPlansParams) WithPaymentMethod(paymentMethod *string) *GetPlansParams {
+	o.SetPaymentMethod(paymentMethod)
+	return o
+}
+
+// SetPaymentMethod adds the paymentMethod to the get plans params
+func (o *GetPlansParams) SetPaymentMethod(paymentMethod *string) {
+	o.PaymentMethod = paymentMethod
+}
+
+// WithPlanGroup adds the planGroup to the get plans params
+func (o *GetPlansParams) WithPlanGroup(planGroup *string) *GetPlansParams {
+	o.SetPlanGroup(planGroup)
+	return o
+}
+
+// SetPlanGroup adds the planGroup to the get plans params
+func (o *GetPlansParams) SetPlanGroup(planGroup *string) {
+	o.PlanGroup = planGroup
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetPlansParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AllowWebSignup != nil {
+
+		// query param allowWebSignup
+		var qrAllowWebSignup bool
+		if o.AllowWebSignup != nil {
+			qrAllowWebSignup = *o.AllowWebSignup
+		}
+		qAllowWebSignup := swag.FormatBool(qrAllowWebSignup)
+		if qAllowWebSignup != "" {
+			if err := r.SetQueryParam("allowWebSignup", qAllowWebSignup); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Available != nil {
+
+		// query param available
+		var qrAvailable bool
+		if o.Available != nil {
+			qrAvailable = *o.Available
+		}
+		qAvailable := swag.FormatBool(qrAvailable)
+		if qAvailable != "" {
+			if err := r.SetQueryParam("available", qAvailable); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.BillingInterval != nil {
+
+		// query param billingInterval
+		var qrBillingInterval string
+		if o.BillingInterval != nil {
+			qrBillingInterval = *o.BillingInterval
+		}
+		qBillingInterval := qrBillingInterval
+		if qBillingInterval != "" {
+			if err := r.SetQueryParam("billingInterval", qBillingInterval); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int32
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt32(qrLimit)
+		if qLimit != "" {
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Offset != nil {
+
+		// query param offset
+		var qrOffset int32
+		if o.Offset != nil {
+			qrOffset = *o.Offset
+		}
+		qOffset := swag.FormatInt32(qrOffset)
+		if qOffset != "" {
+			if err := r.SetQueryParam("offset", qOffset); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.PaymentMethod != nil {
+
+		// query param paymentMethod
+		var qrPaymentMethod string
+		if o.PaymentMethod != nil {
+			qrPaymentMethod = *o.PaymentMethod
+		}
+		qPaymentMethod := qrPaymentMethod
+		if qPaymentMethod != "" {
+			if err := r.SetQueryParam("paymentMethod", qPaymentMethod); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.PlanGroup != nil {
+
+		// query param planGroup
+		var qrPlanGroup string
+		if o.PlanGroup != nil {
+			qrPlanGroup = *o.PlanGroup
+		}
+		qPlanGroup := qrPlanGroup
+		if qPlanGroup != "" {
+			if err := r.SetQueryParam("planGroup", qPlanGroup); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
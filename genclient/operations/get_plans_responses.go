@@ -0,0 +1,157 @@
+package operations
+
+// This file is not generated.  GET /plans is not in the organization api swagger specification yet, so it is written by
+// hand in the form go-swagger generates.  The path and the responses, 200, 401 and 403, are the ones the api is
+// expected to serve, not ones read from the specification.  Delete this file once the client is regenerated from a
+// specification that defines the operation, see README.md.
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/3dsim/organization-goclient/models"
+)
+
+// GetPlansReader is a Reader for the GetPlans structure.
+type GetPlansReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetPlansReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetPlansOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 401:
+		result := NewGetPlansUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 403:
+		result := NewGetPlansForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		result := NewGetPlansDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetPlansOK creates a GetPlansOK with default headers values
+func NewGetPlansOK() *GetPlansOK {
+	return &GetPlansOK{}
+}
+
+/*GetPlansOK handles this case with default header values.
+
+Successfully returned the list of items
+*/
+type GetPlansOK struct {
+	Payload []*models.Plan
+}
+
+func (o *GetPlansOK) Error() string {
+	return fmt.Sprintf("[GET /plans][%d] getPlansOK  %+v", 200, o.Payload)
+}
+
+func (o *GetPlansOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetPlansUnauthorized creates a GetPlansUnauthorized with default headers values
+func NewGetPlansUnauthorized() *GetPlansUnauthorized {
+	return &GetPlansUnauthorized{}
+}
+
+/*GetPlansUnauthorized handles this case with default header values.
+
+Not authorized
+*/
+type GetPlansUnauthorized struct {
+}
+
+func (o *GetPlansUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /plans][%d] getPlansUnauthorized ", 401)
+}
+
+func (o *GetPlansUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewGetPlansForbidden creates a GetPlansForbidden with default headers values
+func NewGetPlansForbidden() *GetPlansForbidden {
+	return &GetPlansForbidden{}
+}
+
+/*GetPlansForbidden handles this case with default header values.
+
+Forbidden
+*/
+type GetPlansForbidden struct {
+}
+
+func (o *GetPlansForbidden) Error() string {
+	return fmt.Sprintf("[GET /plans][%d] getPlansForbidden ", 403)
+}
+
+func (o *GetPlansForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewGetPlansDefault creates a GetPlansDefault with default headers values
+func NewGetPlansDefault(code int) *GetPlansDefault {
+	return &GetPlansDefault{
+		_statusCode: code,
+	}
+}
+
+/*GetPlansDefault handles this case with default header values.
+
+unexpected error
+*/
+type GetPlansDefault struct {
+	_statusCode int
+}
+
+// Code gets the status code for the get plans default response
+func (o *GetPlansDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetPlansDefault) Error() string {
+	return fmt.Sprintf("[GET /plans][%d] getPlans default ", o._statusCode)
+}
+
+func (o *GetPlansDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package operations
+
+// This file is not generated.  GET /organizations/{orgId}/subscriptions/{subId} is not in the organization api swagger
+// specification yet, so it is written by hand in the form go-swagger generates.  The path and the responses, 200, 401,
+// 403 and 404, are the ones the api is expected to serve, not ones read from the specification.  Delete this file once
+// the client is regenerated from a specification that defines the operation, see README.md.
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetSubscriptionParams creates a new GetSubscriptionParams object
+// with the default values initialized.
+func NewGetSubscriptionParams() *GetSubscriptionParams {
+	var ()
+	return &GetSubscriptionParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetSubscriptionParamsWithTimeout creates a new GetSubscriptionParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetSubscriptionParamsWithTimeout(timeout time.Duration) *GetSubscriptionParams {
+	var ()
+	return &GetSubscriptionParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetSubscriptionParamsWithContext creates a new GetSubscriptionParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetSubscriptionParamsWithContext(ctx context.Context) *GetSubscriptionParams {
+	var ()
+	return &GetSubscriptionParams{
+
+		Context: ctx,
+	}
+}
+
+// NewGetSubscriptionParamsWithHTTPClient creates a new GetSubscriptionParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetSubscriptionParamsWithHTTPClient(client *http.Client) *GetSubscriptionParams {
+	var ()
+	return &GetSubscriptionParams{
+		HTTPClient: client,
+	}
+}
+
+/*GetSubscriptionParams contains all the parameters to send to the API endpoint
+for the get subscription operation typically these are written to a http.Request
+*/
+type GetSubscriptionParams struct {
+
+	/*OrgID
+	  organization id
+
+	*/
+	OrgID int32
+	/*SubID
+	  subscription id
+
+	*/
+	SubID int32
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get subscription params
+func (o *GetSubscriptionParams) WithTimeout(timeout time.Duration) *GetSubscriptionParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get subscription params
+func (o *GetSubscriptionParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get subscription params
+func (o *GetSubscriptionParams) WithContext(ctx context.Context) *GetSubscriptionParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get subscription params
+func (o *GetSubscriptionParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get subscription params
+func (o *GetSubscriptionParams) WithHTTPClient(client *http.Client) *GetSubscriptionParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get subscription params
+func (o *GetSubscriptionParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithOrgID adds the orgID to the get subscription params
+func (o *GetSubscriptionParams) WithOrgID(orgID int32) *GetSubscriptionParams {
+	o.SetOrgID(orgID)
+	return o
+}
+
+// SetOrgID adds the orgId to the get subscription params
+func (o *GetSubscriptionParams) SetOrgID(orgID int32) {
+	o.OrgID = orgID
+}
+
+// WithSubID adds the subID to the get subscription params
+func (o *GetSubscriptionParams) WithSubID(subID int32) *GetSubscriptionParams {
+	o.SetSubID(subID)
+	return o
+}
+
+// SetSubID adds the subId to the get subscription params
+func (o *GetSubscriptionParams) SetSubID(subID int32) {
+	o.SubID = subID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetSubscriptionParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param orgId
+	if err := r.SetPathParam("orgId", swag.FormatInt32(o.OrgID)); err != nil {
+		return err
+	}
+
+	// path param subId
+	if err := r.SetPathParam("subId", swag.FormatInt32(o.SubID)); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package operations
+
+// This file is not generated.  GET /organizations/{orgId}/subscriptions/{subId} is not in the organization api swagger
+// specification yet, so it is written by hand in the form go-swagger generates.  The path and the responses, 200, 401,
+// 403 and 404, are the ones the api is expected to serve, not ones read from the specification.  Delete this file once
+// the client is regenerated from a specification that defines the operation, see README.md.
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/3dsim/organization-goclient/models"
+)
+
+// GetSubscriptionReader is a Reader for the GetSubscription structure.
+type GetSubscriptionReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetSubscriptionReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetSubscriptionOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 401:
+		result := NewGetSubscriptionUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 403:
+		result := NewGetSubscriptionForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 404:
+		result := NewGetSubscriptionNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		result := NewGetSubscriptionDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetSubscriptionOK creates a GetSubscriptionOK with default headers values
+func NewGetSubscriptionOK() *GetSubscriptionOK {
+	return &GetSubscriptionOK{}
+}
+
+/*GetSubscriptionOK handles this case with default header values.
+
+Successfully returned subscription
+*/
+type GetSubscriptionOK struct {
+	Payload *models.Subscription
+}
+
+func (o *GetSubscriptionOK) Error() string {
+	return fmt.Sprintf("[GET /organizations/{orgId}/subscriptions/{subId}][%d] getSubscriptionOK  %+v", 200, o.Payload)
+}
+
+func (o *GetSubscriptionOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Subscription)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetSubscriptionUnauthorized creates a GetSubscriptionUnauthorized with default headers values
+func NewGetSubscriptionUnauthorized() *GetSubscriptionUnauthorized {
+	return &GetSubscriptionUnauthorized{}
+}
+
+/*GetSubscriptionUnauthorized handles this case with default header values.
+
+Not authorized
+*/
+type GetSubscriptionUnauthorized struct {
+}
+
+func (o *GetSubscriptionUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /organizations/{orgId}/subscriptions/{subId}][%d] getSubscriptionUnauthorized ", 401)
+}
+
+func (o *GetSubscriptionUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewGetSubscriptionForbidden creates a GetSubscriptionForbidden with default headers values
+func NewGetSubscriptionForbidden() *GetSubscriptionForbidden {
+	return &GetSubscriptionForbidden{}
+}
+
+/*GetSubscriptionForbidden handles this case with default header values.
+
+Forbidden
+*/
+type GetSubscriptionForbidden struct {
+}
+
+func (o *GetSubscriptionForbidden) Error() string {
+	return fmt.Sprintf("[GET /organizations/{orgId}/subscriptions/{subId}][%d] getSubscriptionForbidden ", 403)
+}
+
+func (o *GetSubscriptionForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewGetSubscriptionNotFound creates a GetSubscriptionNotFound with default headers values
+func NewGetSubscriptionNotFound() *GetSubscriptionNotFound {
+	return &GetSubscriptionNotFound{}
+}
+
+/*GetSubscriptionNotFound handles this case with default header values.
+
+Subscription not found
+*/
+type GetSubscriptionNotFound struct {
+}
+
+func (o *GetSubscriptionNotFound) Error() string {
+	return fmt.Sprintf("[GET /organizations/{orgId}/subscriptions/{subId}][%d] getSubscriptionNotFound ", 404)
+}
+
+func (o *GetSubscriptionNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewGetSubscriptionDefault creates a GetSubscriptionDefault with default headers values
+func NewGetSubscriptionDefault(code int) *GetSubscriptionDefault {
+	return &GetSubscriptionDefault{
+		_statusCode: code,
+	}
+}
+
+/*GetSubscriptionDefault handles this case with default header values.
+
+unexpected error
+*/
+type GetSubscriptionDefault struct {
+	_statusCode int
+}
+
+// Code gets the status code for the get subscription default response
+func (o *GetSubscriptionDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetSubscriptionDefault) Error() string {
+	return fmt.Sprintf("[GET /organizations/{orgId}/subscriptions/{subId}][%d] getSubscription default ", o._statusCode)
+}
+
+func (o *GetSubscriptionDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package operations
+
+// This file is not generated.  GET /organizations/{orgId}/subscriptions is not in the organization api swagger
+// specification yet, so it is written by hand in the form go-swagger generates.  The path and the responses, 200, 401,
+// 403 and 404, are the ones the api is expected to serve, not ones read from the specification.  Delete this file once
+// the client is regenerated from a specification that defines the operation, see README.md.
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetSubscriptionsByOrganizationParams creates a new GetSubscriptionsByOrganizationParams object
+// with the default values initialized.
+func NewGetSubscriptionsByOrganizationParams() *GetSubscriptionsByOrganizationParams {
+	var ()
+	return &GetSubscriptionsByOrganizationParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetSubscriptionsByOrganizationParamsWithTimeout creates a new GetSubscriptionsByOrganizationParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetSubscriptionsByOrganizationParamsWithTimeout(timeout time.Duration) *GetSubscriptionsByOrganizationParams {
+	var ()
+	return &GetSubscriptionsByOrganizationParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetSubscriptionsByOrganizationParamsWithContext creates a new GetSubscriptionsByOrganizationParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetSubscriptionsByOrganizationParamsWithContext(ctx context.Context) *GetSubscriptionsByOrganizationParams {
+	var ()
+	return &GetSubscriptionsByOrganizationParams{
+
+		Context: ctx,
+	}
+}
+
+// NewGetSubscriptionsByOrganizationParamsWithHTTPClient creates a new GetSubscriptionsByOrganizationParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetSubscriptionsByOrganizationParamsWithHTTPClient(client *http.Client) *GetSubscriptionsByOrganizationParams {
+	var ()
+	return &GetSubscriptionsByOrganizationParams{
+		HTTPClient: client,
+	}
+}
+
+/*GetSubscriptionsByOrganizationParams contains all the parameters to send to the API endpoint
+for the get subscriptions by organization operation typically these are written to a http.Request
+*/
+type GetSubscriptionsByOrganizationParams struct {
+
+	/*OrgID
+	  organization id
+
+	*/
+	OrgID int32
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get subscriptions by organization params
+func (o *GetSubscriptionsByOrganizationParams) WithTimeout(timeout time.Duration) *GetSubscriptionsByOrganizationParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get subscriptions by organization params
+func (o *GetSubscriptionsByOrganizationParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get subscriptions by organization params
+func (o *GetSubscriptionsByOrganizationParams) WithContext(ctx context.Context) *GetSubscriptionsByOrganizationParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get subscriptions by organization params
+func (o *GetSubscriptionsByOrganizationParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get subscriptions by organization params
+func (o *GetSubscriptionsByOrganizationParams) WithHTTPClient(client *http.Client) *GetSubscriptionsByOrganizationParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get subscriptions by organization params
+func (o *GetSubscriptionsByOrganizationParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithOrgID adds the orgID to the get subscriptions by organization params
+func (o *GetSubscriptionsByOrganizationParams) WithOrgID(orgID int32) *GetSubscriptionsByOrganizationParams {
+	o.SetOrgID(orgID)
+	return o
+}
+
+// SetOrgID adds the orgId to the get subscriptions by organization params
+func (o *GetSubscriptionsByOrganizationParams) SetOrgID(orgID int32) {
+	o.OrgID = orgID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetSubscriptionsByOrganizationParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param orgId
+	if err := r.SetPathParam("orgId", swag.FormatInt32(o.OrgID)); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package operations
+
+// This file is not generated.  GET /organizations/{orgId}/subscriptions is not in the organization api swagger
+// specification yet, so it is written by hand in the form go-swagger generates.  The path and the responses, 200, 401,
+// 403 and 404, are the ones the api is expected to serve, not ones read from the specification.  Delete this file once
+// the client is regenerated from a specification that defines the operation, see README.md.
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/3dsim/organization-goclient/models"
+)
+
+// GetSubscriptionsByOrganizationReader is a Reader for the GetSubscriptionsByOrganization structure.
+type GetSubscriptionsByOrganizationReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetSubscriptionsByOrganizationReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetSubscriptionsByOrganizationOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 401:
+		result := NewGetSubscriptionsByOrganizationUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 403:
+		result := NewGetSubscriptionsByOrganizationForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 404:
+		result := NewGetSubscriptionsByOrganizationNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		result := NewGetSubscriptionsByOrganizationDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetSubscriptionsByOrganizationOK creates a GetSubscriptionsByOrganizationOK with default headers values
+func NewGetSubscriptionsByOrganizationOK() *GetSubscriptionsByOrganizationOK {
+	return &GetSubscriptionsByOrganizationOK{}
+}
+
+/*GetSubscriptionsByOrganizationOK handles this case with default header values.
+
+Successfully returned the list of items
+*/
+type GetSubscriptionsByOrganizationOK struct {
+	Payload []*models.Subscription
+}
+
+func (o *GetSubscriptionsByOrganizationOK) Error() string {
+	return fmt.Sprintf("[GET /organizations/{orgId}/subscriptions][%d] getSubscriptionsByOrganizationOK  %+v", 200, o.Payload)
+}
+
+func (o *GetSubscriptionsByOrganizationOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetSubscriptionsByOrganizationUnauthorized creates a GetSubscriptionsByOrganizationUnauthorized with default headers values
+func NewGetSubscriptionsByOrganizationUnauthorized() *GetSubscriptionsByOrganizationUnauthorized {
+	return &GetSubscriptionsByOrganizationUnauthorized{}
+}
+
+/*GetSubscriptionsByOrganizationUnauthorized handles this case with default header values.
+
+Not authorized
+*/
+type GetSubscriptionsByOrganizationUnauthorized struct {
+}
+
+func (o *GetSubscriptionsByOrganizationUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /organizations/{orgId}/subscriptions][%d] getSubscriptionsByOrganizationUnauthorized ", 401)
+}
+
+func (o *GetSubscriptionsByOrganizationUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewGetSubscriptionsByOrganizationForbidden creates a GetSubscriptionsByOrganizationForbidden with default headers values
+func NewGetSubscriptionsByOrganizationForbidden() *GetSubscriptionsByOrganizationForbidden {
+	return &GetSubscriptionsByOrganizationForbidden{}
+}
+
+/*GetSubscriptionsByOrganizationForbidden handles this case with default header values.
+
+Forbidden
+*/
+type GetSubscriptionsByOrganizationForbidden struct {
+}
+
+func (o *GetSubscriptionsByOrganizationForbidden) Error() string {
+	return fmt.Sprintf("[GET /organizations/{orgId}/subscriptions][%d] getSubscriptionsByOrganizationForbidden ", 403)
+}
+
+func (o *GetSubscriptionsByOrganizationForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewGetSubscriptionsByOrganizationNotFound creates a GetSubscriptionsByOrganizationNotFound with default headers values
+func NewGetSubscriptionsByOrganizationNotFound() *GetSubscriptionsByOrganizationNotFound {
+	return &GetSubscriptionsByOrganizationNotFound{}
+}
+
+/*GetSubscriptionsByOrganizationNotFound handles this case with default header values.
+
+Organization not found
+*/
+type GetSubscriptionsByOrganizationNotFound struct {
+}
+
+func (o *GetSubscriptionsByOrganizationNotFound) Error() string {
+	return fmt.Sprintf("[GET /organizations/{orgId}/subscriptions][%d] getSubscriptionsByOrganizationNotFound ", 404)
+}
+
+func (o *GetSubscriptionsByOrganizationNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewGetSubscriptionsByOrganizationDefault creates a GetSubscriptionsByOrganizationDefault with default headers values
+func NewGetSubscriptionsByOrganizationDefault(code int) *GetSubscriptionsByOrganizationDefault {
+	return &GetSubscriptionsByOrganizationDefault{
+		_statusCode: code,
+	}
+}
+
+/*GetSubscriptionsByOrganizationDefault handles this case with default header values.
+
+unexpected error
+*/
+type GetSubscriptionsByOrganizationDefault struct {
+	_statusCode int
+}
+
+// Code gets the status code for the get subscriptions by organization default response
+func (o *GetSubscriptionsByOrganizationDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetSubscriptionsByOrganizationDefault) Error() string {
+	return fmt.Sprintf("[GET /organizations/{orgId}/subscriptions][%d] getSubscriptionsByOrganization default ", o._statusCode)
+}
+
+func (o *GetSubscriptionsByOrganizationDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package operations
+
+// This file is not generated.  GET /users/{userId}/impersonate is not in the organization api swagger specification
+// yet, so it is written by hand in the form go-swagger generates.  The path and the responses, 200, 401, 403 and 404,
+// are the ones the api is expected to serve, not ones read from the specification.  Delete this file once the client is
+// regenerated from a specification that defines the operation, see README.md.
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewImpersonateUserParams creates a new ImpersonateUserParams object
+// with the default values initialized.
+func NewImpersonateUserParams() *ImpersonateUserParams {
+	var ()
+	return &ImpersonateUserParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewImpersonateUserParamsWithTimeout creates a new ImpersonateUserParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewImpersonateUserParamsWithTimeout(timeout time.Duration) *ImpersonateUserParams {
+	var ()
+	return &ImpersonateUserParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewImpersonateUserParamsWithContext creates a new ImpersonateUserParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewImpersonateUserParamsWithContext(ctx context.Context) *ImpersonateUserParams {
+	var ()
+	return &ImpersonateUserParams{
+
+		Context: ctx,
+	}
+}
+
+// NewImpersonateUserParamsWithHTTPClient creates a new ImpersonateUserParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewImpersonateUserParamsWithHTTPClient(client *http.Client) *ImpersonateUserParams {
+	var ()
+	return &ImpersonateUserParams{
+		HTTPClient: client,
+	}
+}
+
+/*ImpersonateUserParams contains all the parameters to send to the API endpoint
+for the impersonate user operation typically these are written to a http.Request
+*/
+type ImpersonateUserParams struct {
+
+	/*UserID
+	  ID of the user to impersonate
+
+	*/
+	UserID string
+	/*OrganizationID
+	  ID of the organization to impersonate the user in
+
+	*/
+	OrganizationID *int32
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the impersonate user params
+func (o *ImpersonateUserParams) WithTimeout(timeout time.Duration) *ImpersonateUserParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the impersonate user params
+func (o *ImpersonateUserParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the impersonate user params
+func (o *ImpersonateUserParams) WithContext(ctx context.Context) *ImpersonateUserParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the impersonate user params
+func (o *ImpersonateUserParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the impersonate user params
+func (o *ImpersonateUserParams) WithHTTPClient(client *http.Client) *ImpersonateUserParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the impersonate user params
+func (o *ImpersonateUserParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithUserID adds the userID to the impersonate user params
+func (o *ImpersonateUserParams) WithUserID(userID string) *ImpersonateUserParams {
+	o.SetUserID(userID)
+	return o
+}
+
+// SetUserID adds the userId to the impersonate user params
+func (o *ImpersonateUserParams) SetUserID(userID string) {
+	o.UserID = userID
+}
+
+// WithOrganizationID adds the organizationID to the impersonate user params
+func (o *ImpersonateUserParams) WithOrganizationID(organizationID *int32) *ImpersonateUserParams {
+	o.SetOrganizationID(organizationID)
+	return o
+}
+
+// SetOrganizationID adds the organizationId to the impersonate user params
+func (o *ImpersonateUserParams) SetOrganizationID(organizationID *int32) {
+	o.OrganizationID = organizationID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ImpersonateUserParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param userId
+	if err := r.SetPathParam("userId", o.UserID); err != nil {
+		return err
+	}
+
+	if o.OrganizationID != nil {
+
+		// query param organizationId
+		var qrOrganizationID int32
+		if o.OrganizationID != nil {
+			qrOrganizationID = *o.OrganizationID
+		}
+		qOrganizationID := swag.FormatInt32(qrOrganizationID)
+		if qOrganizationID != "" {
+			if err := r.SetQueryParam("organizationId", qOrganizationID); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package operations
+
+// This file is not generated.  GET /users/{userId}/impersonate is not in the organization api swagger specification
+// yet, so it is written by hand in the form go-swagger generates.  The path and the responses, 200, 401, 403 and 404,
+// are the ones the api is expected to serve, not ones read from the specification.  Delete this file once the client is
+// regenerated from a specification that defines the operation, see README.md.
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/3dsim/organization-goclient/models"
+)
+
+// ImpersonateUserReader is a Reader for the ImpersonateUser structure.
+type ImpersonateUserReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ImpersonateUserReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewImpersonateUserOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 401:
+		result := NewImpersonateUserUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 403:
+		result := NewImpersonateUserForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 404:
+		result := NewImpersonateUserNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		result := NewImpersonateUserDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewImpersonateUserOK creates a ImpersonateUserOK with default headers values
+func NewImpersonateUserOK() *ImpersonateUserOK {
+	return &ImpersonateUserOK{}
+}
+
+/*ImpersonateUserOK handles this case with default header values.
+
+Successfully returned the impersonation url
+*/
+type ImpersonateUserOK struct {
+	Payload *models.ImpersonateURL
+}
+
+func (o *ImpersonateUserOK) Error() string {
+	return fmt.Sprintf("[GET /users/{userId}/impersonate][%d] impersonateUserOK  %+v", 200, o.Payload)
+}
+
+func (o *ImpersonateUserOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ImpersonateURL)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewImpersonateUserUnauthorized creates a ImpersonateUserUnauthorized with default headers values
+func NewImpersonateUserUnauthorized() *ImpersonateUserUnauthorized {
+	return &ImpersonateUserUnauthorized{}
+}
+
+/*ImpersonateUserUnauthorized handles this case with default header values.
+
+Not authorized
+*/
+type ImpersonateUserUnauthorized struct {
+}
+
+func (o *ImpersonateUserUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /users/{userId}/impersonate][%d] impersonateUserUnauthorized ", 401)
+}
+
+func (o *ImpersonateUserUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewImpersonateUserForbidden creates a ImpersonateUserForbidden with default headers values
+func NewImpersonateUserForbidden() *ImpersonateUserForbidden {
+	return &ImpersonateUserForbidden{}
+}
+
+/*ImpersonateUserForbidden handles this case with default header values.
+
+Forbidden
+*/
+type ImpersonateUserForbidden struct {
+}
+
+func (o *ImpersonateUserForbidden) Error() string {
+	return fmt.Sprintf("[GET /users/{userId}/impersonate][%d] impersonateUserForbidden ", 403)
+}
+
+func (o *ImpersonateUserForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewImpersonateUserNotFound creates a ImpersonateUserNotFound with default headers values
+func NewImpersonateUserNotFound() *ImpersonateUserNotFound {
+	return &ImpersonateUserNotFound{}
+}
+
+/*ImpersonateUserNotFound handles this case with default header values.
+
+User not found
+*/
+type ImpersonateUserNotFound struct {
+}
+
+func (o *ImpersonateUserNotFound) Error() string {
+	return fmt.Sprintf("[GET /users/{userId}/impersonate][%d] impersonateUserNotFound ", 404)
+}
+
+func (o *ImpersonateUserNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewImpersonateUserDefault creates a ImpersonateUserDefault with default headers values
+func NewImpersonateUserDefault(code int) *ImpersonateUserDefault {
+	return &ImpersonateUserDefault{
+		_statusCode: code,
+	}
+}
+
+/*ImpersonateUserDefault handles this case with default header values.
+
+unexpected error
+*/
+type ImpersonateUserDefault struct {
+	_statusCode int
+}
+
+// Code gets the status code for the impersonate user default response
+func (o *ImpersonateUserDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ImpersonateUserDefault) Error() string {
+	return fmt.Sprintf("[GET /users/{userId}/impersonate][%d] impersonateUser default ", o._statusCode)
+}
+
+func (o *ImpersonateUserDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
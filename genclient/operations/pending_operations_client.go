@@ -0,0 +1,270 @@
+package operations
+
+// This file is not generated.  It holds the operations of the organization api that are not in its swagger
+// specification yet, written by hand in the form go-swagger generates so that the organization package can use them.
+// See "Operations not in the specification" in README.md.
+
+import (
+	"github.com/go-openapi/runtime"
+)
+
+/*
+AddUserToOrganization Create a user in an organization
+*/
+func (a *Client) AddUserToOrganization(params *AddUserToOrganizationParams, authInfo runtime.ClientAuthInfoWriter) (*AddUserToOrganizationOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAddUserToOrganizationParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "addUserToOrganization",
+		Method:             "POST",
+		PathPattern:        "/organizations/{id}/users",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &AddUserToOrganizationReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*AddUserToOrganizationOK), nil
+
+}
+
+/*
+ImpersonateUser Returns a URL that logs in as the user
+*/
+func (a *Client) ImpersonateUser(params *ImpersonateUserParams, authInfo runtime.ClientAuthInfoWriter) (*ImpersonateUserOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewImpersonateUserParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "impersonateUser",
+		Method:             "GET",
+		PathPattern:        "/users/{userId}/impersonate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ImpersonateUserReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ImpersonateUserOK), nil
+
+}
+
+/*
+AddOrganization Create an organization
+*/
+func (a *Client) AddOrganization(params *AddOrganizationParams, authInfo runtime.ClientAuthInfoWriter) (*AddOrganizationOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAddOrganizationParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "addOrganization",
+		Method:             "POST",
+		PathPattern:        "/organizations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &AddOrganizationReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*AddOrganizationOK), nil
+
+}
+
+/*
+PutOrganization Update an organization
+*/
+func (a *Client) PutOrganization(params *PutOrganizationParams, authInfo runtime.ClientAuthInfoWriter) (*PutOrganizationOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPutOrganizationParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "putOrganization",
+		Method:             "PUT",
+		PathPattern:        "/organizations/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PutOrganizationReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*PutOrganizationOK), nil
+
+}
+
+/*
+AddSubscription Create a subscription
+*/
+func (a *Client) AddSubscription(params *AddSubscriptionParams, authInfo runtime.ClientAuthInfoWriter) (*AddSubscriptionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAddSubscriptionParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "addSubscription",
+		Method:             "POST",
+		PathPattern:        "/organizations/{orgId}/subscriptions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &AddSubscriptionReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*AddSubscriptionOK), nil
+
+}
+
+/*
+GetSubscription Returns a single subscription
+*/
+func (a *Client) GetSubscription(params *GetSubscriptionParams, authInfo runtime.ClientAuthInfoWriter) (*GetSubscriptionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetSubscriptionParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getSubscription",
+		Method:             "GET",
+		PathPattern:        "/organizations/{orgId}/subscriptions/{subId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetSubscriptionReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetSubscriptionOK), nil
+
+}
+
+/*
+GetPlans Get a list of plans
+*/
+func (a *Client) GetPlans(params *GetPlansParams, authInfo runtime.ClientAuthInfoWriter) (*GetPlansOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetPlansParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getPlans",
+		Method:             "GET",
+		PathPattern:        "/plans",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetPlansReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetPlansOK), nil
+
+}
+
+/*
+GetSubscriptionsByOrganization Get the subscriptions of an organization
+*/
+func (a *Client) GetSubscriptionsByOrganization(params *GetSubscriptionsByOrganizationParams, authInfo runtime.ClientAuthInfoWriter) (*GetSubscriptionsByOrganizationOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetSubscriptionsByOrganizationParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getSubscriptionsByOrganization",
+		Method:             "GET",
+		PathPattern:        "/organizations/{orgId}/subscriptions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetSubscriptionsByOrganizationReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetSubscriptionsByOrganizationOK), nil
+
+}
+
+/*
+CancelSubscription Deactivates a subscription by updating it with active set to false
+*/
+func (a *Client) CancelSubscription(params *CancelSubscriptionParams, authInfo runtime.ClientAuthInfoWriter) (*PutSubscriptionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCancelSubscriptionParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "cancelSubscription",
+		Method:             "PUT",
+		PathPattern:        "/organizations/{orgId}/subscriptions/{subId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PutSubscriptionReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*PutSubscriptionOK), nil
+
+}
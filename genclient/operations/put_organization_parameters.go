@@ -0,0 +1,161 @@
+package operations
+
+// This file is not generated.  PUT /organizations/{id} is not in the organization api swagger specification yet, so it
+// is written by hand in the form go-swagger generates.  The path and the responses, 200, 400, 401, 403 and 404, are the
+// ones the api is expected to serve, not ones read from the specification.  Delete this file once the client is
+// regenerated from a specification that defines the operation, see README.md.
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/3dsim/organization-goclient/models"
+)
+
+// NewPutOrganizationParams creates a new PutOrganizationParams object
+// with the default values initialized.
+func NewPutOrganizationParams() *PutOrganizationParams {
+	var ()
+	return &PutOrganizationParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPutOrganizationParamsWithTimeout creates a new PutOrganizationParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewPutOrganizationParamsWithTimeout(timeout time.Duration) *PutOrganizationParams {
+	var ()
+	return &PutOrganizationParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewPutOrganizationParamsWithContext creates a new PutOrganizationParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewPutOrganizationParamsWithContext(ctx context.Context) *PutOrganizationParams {
+	var ()
+	return &PutOrganizationParams{
+
+		Context: ctx,
+	}
+}
+
+// NewPutOrganizationParamsWithHTTPClient creates a new PutOrganizationParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewPutOrganizationParamsWithHTTPClient(client *http.Client) *PutOrganizationParams {
+	var ()
+	return &PutOrganizationParams{
+		HTTPClient: client,
+	}
+}
+
+/*PutOrganizationParams contains all the parameters to send to the API endpoint
+for the put organization operation typically these are written to a http.Request
+*/
+type PutOrganizationParams struct {
+
+	/*ID
+	  ID of organization
+
+	*/
+	ID int32
+	/*Organization*/
+	Organization *models.Organization
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the put organization params
+func (o *PutOrganizationParams) WithTimeout(timeout time.Duration) *PutOrganizationParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the put organization params
+func (o *PutOrganizationParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the put organization params
+func (o *PutOrganizationParams) WithContext(ctx context.Context) *PutOrganizationParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the put organization params
+func (o *PutOrganizationParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the put organization params
+func (o *PutOrganizationParams) WithHTTPClient(client *http.Client) *PutOrganizationParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the put organization params
+func (o *PutOrganizationParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the put organization params
+func (o *PutOrganizationParams) WithID(id int32) *PutOrganizationParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the put organization params
+func (o *PutOrganizationParams) SetID(id int32) {
+	o.ID = id
+}
+
+// WithOrganization adds the organization to the put organization params
+func (o *PutOrganizationParams) WithOrganization(organization *models.Organization) *PutOrganizationParams {
+	o.SetOrganization(organization)
+	return o
+}
+
+// SetOrganization adds the organization to the put organization params
+func (o *PutOrganizationParams) SetOrganization(organization *models.Organization) {
+	o.Organization = organization
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PutOrganizationParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", swag.FormatInt32(o.ID)); err != nil {
+		return err
+	}
+
+	if o.Organization == nil {
+		o.Organization = new(models.Organization)
+	}
+
+	if err := r.SetBodyParam(o.Organization); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
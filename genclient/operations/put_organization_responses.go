@@ -0,0 +1,215 @@
+package operations
+
+// This file is not generated.  PUT /organizations/{id} is not in the organization api swagger specification yet, so it
+// is written by hand in the form go-swagger generates.  The path and the responses, 200, 400, 401, 403 and 404, are the
+// ones the api is expected to serve, not ones read from the specification.  Delete this file once the client is
+// regenerated from a specification that defines the operation, see README.md.
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/3dsim/organization-goclient/models"
+)
+
+// PutOrganizationReader is a Reader for the PutOrganization structure.
+type PutOrganizationReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PutOrganizationReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewPutOrganizationOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 400:
+		result := NewPutOrganizationBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 401:
+		result := NewPutOrganizationUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 403:
+		result := NewPutOrganizationForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 404:
+		result := NewPutOrganizationNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		result := NewPutOrganizationDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewPutOrganizationOK creates a PutOrganizationOK with default headers values
+func NewPutOrganizationOK() *PutOrganizationOK {
+	return &PutOrganizationOK{}
+}
+
+/*PutOrganizationOK handles this case with default header values.
+
+Successfully returned organization
+*/
+type PutOrganizationOK struct {
+	Payload *models.Organization
+}
+
+func (o *PutOrganizationOK) Error() string {
+	return fmt.Sprintf("[PUT /organizations/{id}][%d] putOrganizationOK  %+v", 200, o.Payload)
+}
+
+func (o *PutOrganizationOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Organization)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPutOrganizationBadRequest creates a PutOrganizationBadRequest with default headers values
+func NewPutOrganizationBadRequest() *PutOrganizationBadRequest {
+	return &PutOrganizationBadRequest{}
+}
+
+/*PutOrganizationBadRequest handles this case with default header values.
+
+Invalid organization
+*/
+type PutOrganizationBadRequest struct {
+}
+
+func (o *PutOrganizationBadRequest) Error() string {
+	return fmt.Sprintf("[PUT /organizations/{id}][%d] putOrganizationBadRequest ", 400)
+}
+
+func (o *PutOrganizationBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewPutOrganizationUnauthorized creates a PutOrganizationUnauthorized with default headers values
+func NewPutOrganizationUnauthorized() *PutOrganizationUnauthorized {
+	return &PutOrganizationUnauthorized{}
+}
+
+/*PutOrganizationUnauthorized handles this case with default header values.
+
+Not authorized
+*/
+type PutOrganizationUnauthorized struct {
+}
+
+func (o *PutOrganizationUnauthorized) Error() string {
+	return fmt.Sprintf("[PUT /organizations/{id}][%d] putOrganizationUnauthorized ", 401)
+}
+
+func (o *PutOrganizationUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewPutOrganizationForbidden creates a PutOrganizationForbidden with default headers values
+func NewPutOrganizationForbidden() *PutOrganizationForbidden {
+	return &PutOrganizationForbidden{}
+}
+
+/*PutOrganizationForbidden handles this case with default header values.
+
+Forbidden
+*/
+type PutOrganizationForbidden struct {
+}
+
+func (o *PutOrganizationForbidden) Error() string {
+	return fmt.Sprintf("[PUT /organizations/{id}][%d] putOrganizationForbidden ", 403)
+}
+
+func (o *PutOrganizationForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewPutOrganizationNotFound creates a PutOrganizationNotFound with default headers values
+func NewPutOrganizationNotFound() *PutOrganizationNotFound {
+	return &PutOrganizationNotFound{}
+}
+
+/*PutOrganizationNotFound handles this case with default header values.
+
+Organization not found
+*/
+type PutOrganizationNotFound struct {
+}
+
+func (o *PutOrganizationNotFound) Error() string {
+	return fmt.Sprintf("[PUT /organizations/{id}][%d] putOrganizationNotFound ", 404)
+}
+
+func (o *PutOrganizationNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewPutOrganizationDefault creates a PutOrganizationDefault with default headers values
+func NewPutOrganizationDefault(code int) *PutOrganizationDefault {
+	return &PutOrganizationDefault{
+		_statusCode: code,
+	}
+}
+
+/*PutOrganizationDefault handles this case with default header values.
+
+unexpected error
+*/
+type PutOrganizationDefault struct {
+	_statusCode int
+}
+
+// Code gets the status code for the put organization default response
+func (o *PutOrganizationDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *PutOrganizationDefault) Error() string {
+	return fmt.Sprintf("[PUT /organizations/{id}][%d] putOrganization default ", o._statusCode)
+}
+
+func (o *PutOrganizationDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
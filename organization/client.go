@@ -3,6 +3,8 @@
 package organization
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -12,9 +14,10 @@ import (
 	"github.com/3dsim/organization-goclient/genclient"
 	"github.com/3dsim/organization-goclient/genclient/operations"
 	"github.com/3dsim/organization-goclient/models"
-	"github.com/PuerkitoBio/rehttp"
+	"github.com/go-openapi/runtime"
 	openapiclient "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
 	log "github.com/inconshreveable/log15"
 )
 
@@ -33,20 +36,188 @@ func init() {
 
 // Client is a wrapper around the generated client found in the "genclient" package.  It provides convenience methods
 // for common operations.  If the operation needed is not found in Client, use the "genclient" package using this client
-// as an example of how to utilize the genclient.  PRs are welcome if more functionality is wanted in this client package.
+// as an example of how to utilize the genclient, and NewTransport for a transport that authenticates its operations.
+// PRs are welcome if more functionality is wanted in this client package.
+//
+// Every method has a "Ctx" variant whose context is used for the token fetch and the API call; the variants without a
+// context use context.Background().  Error responses of the api are returned as an *APIError, see IsNotFound.
 type Client interface {
 	Organizations() ([]*models.Organization, error)
+	OrganizationsCtx(ctx context.Context) ([]*models.Organization, error)
+	OrganizationsWithOptions(options ListOrganizationsOptions) ([]*models.Organization, error)
+	OrganizationsWithOptionsCtx(ctx context.Context, options ListOrganizationsOptions) ([]*models.Organization, error)
 	Organization(organizationID int32) (*models.Organization, error)
+	OrganizationCtx(ctx context.Context, organizationID int32) (*models.Organization, error)
+	// CreateOrganization validates organization with its Validate method and returns the validation error without
+	// calling the api.
+	CreateOrganization(organization *models.Organization) (*models.Organization, error)
+	CreateOrganizationCtx(ctx context.Context, organization *models.Organization) (*models.Organization, error)
+	// UpdateOrganization validates organization like CreateOrganization, and also requires its ID.
+	UpdateOrganization(organization *models.Organization) (*models.Organization, error)
+	UpdateOrganizationCtx(ctx context.Context, organization *models.Organization) (*models.Organization, error)
+	// DeactivateOrganization fetches the organization and updates it with Active set to false.  An organization that is
+	// already inactive is returned without being updated.
+	DeactivateOrganization(organizationID int32) (*models.Organization, error)
+	DeactivateOrganizationCtx(ctx context.Context, organizationID int32) (*models.Organization, error)
 	Subscriptions(limit *int32) ([]*models.Subscription, error)
+	SubscriptionsCtx(ctx context.Context, limit *int32) ([]*models.Subscription, error)
+	SubscriptionsWithOptions(options ListSubscriptionsOptions) ([]*models.Subscription, error)
+	SubscriptionsWithOptionsCtx(ctx context.Context, options ListSubscriptionsOptions) ([]*models.Subscription, error)
+	OrganizationSubscriptions(organizationID int32) ([]*models.Subscription, error)
+	OrganizationSubscriptionsCtx(ctx context.Context, organizationID int32) ([]*models.Subscription, error)
+	Subscription(organizationID, subscriptionID int32) (*models.Subscription, error)
+	SubscriptionCtx(ctx context.Context, organizationID, subscriptionID int32) (*models.Subscription, error)
 	UpdateSubscription(subscription *models.Subscription) (a *models.Subscription, err error)
+	UpdateSubscriptionCtx(ctx context.Context, subscription *models.Subscription) (a *models.Subscription, err error)
+	// CreateSubscription creates an active subscription to the plan.  The payment method is required and must be one
+	// of models.SubscriptionPaymentMethodCreditCard or models.SubscriptionPaymentMethodPurchaseOrder.
+	CreateSubscription(organizationID, planID int32, paymentMethod string) (*models.Subscription, error)
+	CreateSubscriptionCtx(ctx context.Context, organizationID, planID int32, paymentMethod string) (*models.Subscription, error)
+	// CancelSubscription fetches the subscription, sets Active, CanceledAt, CanceledBy and LastModifiedBy, and updates
+	// it.  A *SubscriptionTransitionError is returned without updating the subscription if it is already canceled or
+	// inactive.
+	CancelSubscription(organizationID, subscriptionID int32, canceledBy string) (*models.Subscription, error)
+	CancelSubscriptionCtx(ctx context.Context, organizationID, subscriptionID int32, canceledBy string) (*models.Subscription, error)
+	// ReactivateSubscription fetches the subscription, clears CanceledAt and CanceledBy, sets Active and LastModifiedBy,
+	// and updates it.  A *SubscriptionTransitionError is returned without updating the subscription if it was not
+	// canceled.
+	ReactivateSubscription(organizationID, subscriptionID int32, reactivatedBy string) (*models.Subscription, error)
+	ReactivateSubscriptionCtx(ctx context.Context, organizationID, subscriptionID int32, reactivatedBy string) (*models.Subscription, error)
 	Plan(planID int32) (org *models.Plan, err error)
+	PlanCtx(ctx context.Context, planID int32) (org *models.Plan, err error)
+	Plans(options ListPlansOptions) ([]*models.Plan, error)
+	PlansCtx(ctx context.Context, options ListPlansOptions) ([]*models.Plan, error)
 	OrganizationUsers(organizationID int32) (users []*models.User, err error)
+	OrganizationUsersCtx(ctx context.Context, organizationID int32) (users []*models.User, err error)
+	// CreateOrganizationUser validates user with its Validate method before calling the api, and returns a
+	// *DuplicateEmailError if a user with the same email already exists.
+	CreateOrganizationUser(organizationID int32, user *models.UserPost) (*models.User, error)
+	CreateOrganizationUserCtx(ctx context.Context, organizationID int32, user *models.UserPost) (*models.User, error)
+	// ImpersonationURL returns a URL that logs in as the user.  Who requested it, when and for which user is written to
+	// the client's logger at info level, so an audit trail is kept as long as a log handler is set.  See Log.
+	ImpersonationURL(userID string, options ImpersonationOptions) (*models.ImpersonateURL, error)
+	ImpersonationURLCtx(ctx context.Context, userID string, options ImpersonationOptions) (*models.ImpersonateURL, error)
+}
+
+// ListOrganizationsOptions filters and pages the organizations returned by OrganizationsWithOptions.  Fields left nil
+// are not sent to the api.
+type ListOrganizationsOptions struct {
+	// Active returns only active (true) or only inactive (false) organizations.
+	Active *bool
+	// Limit is the maximum number of organizations to return.
+	Limit *int32
+	// Offset is the number of organizations to skip before the first one returned.
+	Offset *int32
+}
+
+// ListSubscriptionsOptions filters and pages the subscriptions returned by SubscriptionsWithOptions.  Fields left nil
+// are not sent to the api.
+type ListSubscriptionsOptions struct {
+	// Active returns only active (true) or only inactive (false) subscriptions.
+	Active *bool
+	// Limit is the maximum number of subscriptions to return.
+	Limit *int32
+	// Offset is the number of subscriptions to skip before the first one returned.
+	Offset *int32
+	// PaymentMethod returns only subscriptions paid with the given method.  It must be one of
+	// models.SubscriptionPaymentMethodCreditCard or models.SubscriptionPaymentMethodPurchaseOrder.
+	PaymentMethod *string
+}
+
+// validate checks the payment method against the same enum the models.Subscription validator uses.
+func (o ListSubscriptionsOptions) validate() error {
+	if o.PaymentMethod == nil {
+		return nil
+	}
+	subscription := &models.Subscription{PaymentMethod: *o.PaymentMethod}
+	return subscription.Validate(strfmt.Default)
+}
+
+// ImpersonationOptions describes who is requesting an impersonation URL from ImpersonationURL, and for which
+// organization.
+type ImpersonationOptions struct {
+	// OrganizationID impersonates the user in the given organization instead of their default organization.
+	OrganizationID *int32
+	// RequestedBy identifies the person requesting the URL, e.g. the email of the support engineer.  It is required and
+	// is written to the audit log.
+	RequestedBy string
+}
+
+func (o ImpersonationOptions) validate() error {
+	if o.RequestedBy == "" {
+		return errors.New("organization: the requester of an impersonation URL is required")
+	}
+	return nil
+}
+
+// ListPlansOptions filters and pages the plans returned by Plans.  Fields left nil are not sent to the api.  The
+// filters are also applied to the plans the api returns, so Plans only returns matching plans even if the api ignores
+// them, and never more than Limit plans.  Offset depends on the api alone.
+type ListPlansOptions struct {
+	// Available returns only plans that are (true) or are not (false) available.
+	Available *bool
+	// AllowWebSignup returns only plans that do (true) or do not (false) allow signing up on the web.
+	AllowWebSignup *bool
+	// PlanGroup returns only plans in the given plan group.
+	PlanGroup *string
+	// BillingInterval returns only plans billed at the given interval.  It must be one of
+	// models.PlanBillingIntervalMonthly or models.PlanBillingIntervalYearly.
+	BillingInterval *string
+	// PaymentMethod returns only plans paid with the given method.  It must be one of
+	// models.PlanPaymentMethodCreditCard or models.PlanPaymentMethodPurchaseOrder.
+	PaymentMethod *string
+	// Limit is the maximum number of plans to return.
+	Limit *int32
+	// Offset is the number of plans to skip before the first one returned.
+	Offset *int32
+}
+
+// validate checks the billing interval and payment method against the enums of models.Plan.
+func (o ListPlansOptions) validate() error {
+	if o.BillingInterval != nil {
+		switch *o.BillingInterval {
+		case models.PlanBillingIntervalMonthly, models.PlanBillingIntervalYearly:
+		default:
+			return fmt.Errorf("organization: billing interval %q must be one of %s or %s", *o.BillingInterval,
+				models.PlanBillingIntervalMonthly, models.PlanBillingIntervalYearly)
+		}
+	}
+	if o.PaymentMethod != nil {
+		switch *o.PaymentMethod {
+		case models.PlanPaymentMethodCreditCard, models.PlanPaymentMethodPurchaseOrder:
+		default:
+			return fmt.Errorf("organization: payment method %q must be one of %s or %s", *o.PaymentMethod,
+				models.PlanPaymentMethodCreditCard, models.PlanPaymentMethodPurchaseOrder)
+		}
+	}
+	return nil
+}
+
+// filter returns the plans that match the options, at most Limit of them.
+func (o ListPlansOptions) filter(plans []*models.Plan) []*models.Plan {
+	filtered := make([]*models.Plan, 0, len(plans))
+	for _, plan := range plans {
+		switch {
+		case plan == nil,
+			o.Available != nil && plan.Available != *o.Available,
+			o.AllowWebSignup != nil && plan.AllowWebSignup != *o.AllowWebSignup,
+			o.PlanGroup != nil && plan.PlanGroup != *o.PlanGroup,
+			o.BillingInterval != nil && plan.BillingInterval != *o.BillingInterval,
+			o.PaymentMethod != nil && plan.PaymentMethod != *o.PaymentMethod:
+			continue
+		}
+		filtered = append(filtered, plan)
+	}
+	if o.Limit != nil && *o.Limit >= 0 && int(*o.Limit) < len(filtered) {
+		filtered = filtered[:*o.Limit]
+	}
+	return filtered
 }
 
 type client struct {
-	tokenFetcher auth0.TokenFetcher
-	client       *genclient.Organization
-	audience     string
+	client *genclient.Organization
+	log    log.Logger
+	now    func() time.Time
 }
 
 // NewClient creates a new client for interacting with the 3DSIM organization api.  See the auth0 package for how to construct
@@ -65,101 +236,254 @@ type client struct {
 // 		QA (Azure)	= https://organization-qa.ansys-additive.com
 //		Prod 		= https://organization.3dsim.com/v2
 // 		Gov 		= https://organization-gov.3dsim.com
+//
+// These values are also predefined as the environments QAAWS, QAAzure, Prod and Gov.  See NewClientForEnvironment.
 func NewClient(tokenFetcher auth0.TokenFetcher, apiGatewayURL, apiBasePath, audience string) Client {
-	return newClient(tokenFetcher, apiGatewayURL, apiBasePath, audience, nil, openapiclient.DefaultTimeout)
+	return mustNewClient(config{
+		tokenFetcher:    tokenFetcher,
+		apiGatewayURL:   apiGatewayURL,
+		apiBasePath:     apiBasePath,
+		audience:        audience,
+		timeout:         openapiclient.DefaultTimeout,
+		logger:          Log,
+		tokenExpirySkew: DefaultTokenExpirySkew,
+	})
 }
 
-// NewClientWithRetry creates the same type of client as NewClient, but allows for retrying any temporary errors or
-// any responses with status >= 400 and < 600 for a specified amount of time.
+// NewClientWithTimeout creates the same type of client as NewClient, but with its own request timeout instead of
+// openapiclient.DefaultTimeout.  The timeout only applies to this client.  A timeout of 0 means no timeout.
+func NewClientWithTimeout(tokenFetcher auth0.TokenFetcher, apiGatewayURL, apiBasePath, audience string, timeout time.Duration) Client {
+	return mustNewClient(config{
+		tokenFetcher:    tokenFetcher,
+		apiGatewayURL:   apiGatewayURL,
+		apiBasePath:     apiBasePath,
+		audience:        audience,
+		timeout:         timeout,
+		logger:          Log,
+		tokenExpirySkew: DefaultTokenExpirySkew,
+	})
+}
+
+// NewClientWithRetry creates the same type of client as NewClient, but allows for retrying connection errors or
+// responses with status 408, 429 or >= 500 for a specified amount of time.  PUT requests such as UpdateSubscription
+// are not retried; use New with WithRetryPolicy and TransientRetryPolicy to opt in.  The retryTimeout is also the
+// request timeout of this client.  A retryTimeout of 0 means no timeout, in which case a request is still retried at
+// most 3 times, see TransientRetryPolicy.
 func NewClientWithRetry(tokenFetcher auth0.TokenFetcher, apiGatewayURL, apiBasePath, audience string, retryTimeout time.Duration) Client {
-	tr := rehttp.NewTransport(
-		nil, // will use http.DefaultTransport
-		rehttp.RetryAny(rehttp.RetryStatusInterval(400, 600), rehttp.RetryTemporaryErr()),
-		rehttp.ExpJitterDelay(1*time.Second, retryTimeout),
-	)
-	return newClient(tokenFetcher, apiGatewayURL, apiBasePath, audience, tr, retryTimeout)
+	retryPolicy := TransientRetryPolicy(retryTimeout, false)
+	return mustNewClient(config{
+		tokenFetcher:    tokenFetcher,
+		apiGatewayURL:   apiGatewayURL,
+		apiBasePath:     apiBasePath,
+		audience:        audience,
+		timeout:         retryTimeout,
+		retryPolicy:     &retryPolicy,
+		logger:          Log,
+		tokenExpirySkew: DefaultTokenExpirySkew,
+	})
 }
 
-func newClient(tokenFetcher auth0.TokenFetcher, apiGatewayURL, apiBasePath, audience string,
-	roundTripper http.RoundTripper, defaultRequestTimeout time.Duration) Client {
-	parsedURL, err := url.Parse(apiGatewayURL)
+func mustNewClient(cfg config) Client {
+	c, err := newClient(cfg)
 	if err != nil {
-		message := "API Gateway URL was invalid!"
-		Log.Error(message, "apiGatewayURL", apiGatewayURL)
-		panic(message + " " + err.Error())
+		panic(err.Error())
 	}
-	organizationTransport := openapiclient.New(parsedURL.Host, apiBasePath, []string{parsedURL.Scheme})
-	organizationTransport.Debug = true
-	if roundTripper != nil {
-		organizationTransport.Transport = roundTripper
+	return c
+}
+
+func newClient(cfg config) (Client, error) {
+	transport, err := newTransport(cfg)
+	if err != nil {
+		return nil, err
 	}
-	openapiclient.DefaultTimeout = defaultRequestTimeout
-	organizationClient := genclient.New(organizationTransport, strfmt.Default)
 	return &client{
-		tokenFetcher: tokenFetcher,
-		client:       organizationClient,
-		audience:     audience,
+		client: genclient.New(transport, strfmt.Default),
+		log:    cfg.logger,
+		now:    time.Now,
+	}, nil
+}
+
+// newTransport builds the pipeline described by NewTransport around the HTTP transport configured by cfg.
+func newTransport(cfg config) (runtime.ClientTransport, error) {
+	parsedURL, err := url.Parse(cfg.apiGatewayURL)
+	if err != nil {
+		message := "API Gateway URL was invalid!"
+		cfg.logger.Error(message, "apiGatewayURL", cfg.apiGatewayURL)
+		return nil, errors.New(message + " " + err.Error())
+	}
+	roundTripper := cfg.roundTripper
+	if roundTripper == nil && cfg.httpClient != nil {
+		roundTripper = cfg.httpClient.Transport
+	}
+	if roundTripper == nil {
+		roundTripper = http.DefaultTransport
+	}
+	credentials := cfg.credentials
+	if credentials == nil {
+		credentials = TokenFetcherCredentials(cfg.tokenFetcher, cfg.audience, cfg.tokenExpirySkew)
+	}
+	if cfg.debug {
+		// The debug transport is innermost so that every attempt of a retried request is logged.
+		roundTripper = &debugTransport{next: roundTripper, logger: cfg.logger, headers: credentialHeaders(credentials)}
 	}
+	if cfg.retryPolicy != nil {
+		roundTripper = cfg.retryPolicy.transport(roundTripper)
+	}
+	if cfg.userAgent != "" {
+		roundTripper = &userAgentTransport{next: roundTripper, userAgent: cfg.userAgent}
+	}
+	httpClient := &http.Client{}
+	if cfg.httpClient != nil {
+		*httpClient = *cfg.httpClient
+	}
+	httpClient.Transport = roundTripper
+	organizationTransport := openapiclient.NewWithClient(parsedURL.Host, cfg.apiBasePath, []string{parsedURL.Scheme}, httpClient)
+	// The runtime dumps requests to stderr, tokens included, when the DEBUG environment variable is set.  Debug output
+	// goes through debugTransport instead.
+	organizationTransport.Debug = false
+	interceptors := []Interceptor{RecoveryInterceptor(), TimeoutInterceptor(cfg.timeout)}
+	interceptors = append(interceptors, cfg.interceptors...)
+	interceptors = append(interceptors, AuthInterceptor(credentials))
+	return Chain(&apiErrorTransport{next: organizationTransport}, interceptors...), nil
 }
 
 func (c *client) Organizations() (orgList []*models.Organization, err error) {
-	defer func() {
-		// Until this issue is resolved: https://github.com/go-swagger/go-swagger/issues/1021, we need to recover from
-		// panics.
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Recovered from panic: %v", r)
-		}
-	}()
-	token, err := c.tokenFetcher.Token(c.audience)
+	return c.OrganizationsCtx(context.Background())
+}
+
+func (c *client) OrganizationsCtx(ctx context.Context) (orgList []*models.Organization, err error) {
+	return c.OrganizationsWithOptionsCtx(ctx, ListOrganizationsOptions{})
+}
+
+func (c *client) OrganizationsWithOptions(options ListOrganizationsOptions) (orgList []*models.Organization, err error) {
+	return c.OrganizationsWithOptionsCtx(context.Background(), options)
+}
+
+func (c *client) OrganizationsWithOptionsCtx(ctx context.Context, options ListOrganizationsOptions) (orgList []*models.Organization, err error) {
+	params := operations.NewGetOrganizationsParamsWithContext(ctx).
+		WithActive(options.Active).
+		WithLimit(options.Limit).
+		WithOffset(options.Offset)
+	response, err := c.client.Operations.GetOrganizations(params, nil)
 	if err != nil {
 		return nil, err
 	}
-	params := operations.NewGetOrganizationsParams()
-	response, err := c.client.Operations.GetOrganizations(params, openapiclient.BearerToken(token))
+	return response.Payload, nil
+}
+
+func (c *client) Organization(organizationID int32) (org *models.Organization, err error) {
+	return c.OrganizationCtx(context.Background(), organizationID)
+}
+
+func (c *client) OrganizationCtx(ctx context.Context, organizationID int32) (org *models.Organization, err error) {
+	params := operations.NewFindOrganizationByIDParamsWithContext(ctx).WithID(organizationID)
+	response, err := c.client.Operations.FindOrganizationByID(params, nil)
 	if err != nil {
 		return nil, err
 	}
 	return response.Payload, nil
 }
 
-func (c *client) Organization(organizationID int32) (org *models.Organization, err error) {
-	defer func() {
-		// Until this issue is resolved: https://github.com/go-swagger/go-swagger/issues/1021, we need to recover from
-		// panics.
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Recovered from panic: %v", r)
-		}
-	}()
+func (c *client) CreateOrganization(organization *models.Organization) (*models.Organization, error) {
+	return c.CreateOrganizationCtx(context.Background(), organization)
+}
+
+func (c *client) CreateOrganizationCtx(ctx context.Context, organization *models.Organization) (org *models.Organization, err error) {
+	if err := validateOrganization(organization); err != nil {
+		return nil, err
+	}
 
-	token, err := c.tokenFetcher.Token(c.audience)
+	params := operations.NewAddOrganizationParamsWithContext(ctx).WithOrganization(organization)
+	response, err := c.client.Operations.AddOrganization(params, nil)
 	if err != nil {
 		return nil, err
 	}
-	params := operations.NewFindOrganizationByIDParams().WithID(organizationID)
-	response, err := c.client.Operations.FindOrganizationByID(params, openapiclient.BearerToken(token))
+	return response.Payload, nil
+}
+
+func (c *client) UpdateOrganization(organization *models.Organization) (*models.Organization, error) {
+	return c.UpdateOrganizationCtx(context.Background(), organization)
+}
+
+func (c *client) UpdateOrganizationCtx(ctx context.Context, organization *models.Organization) (org *models.Organization, err error) {
+	if err := validateOrganization(organization); err != nil {
+		return nil, err
+	}
+	// The id is optional in the model since the api assigns it on create, but an update without it would be sent to
+	// /organizations/0.
+	if err := validate.Required("id", "body", organization.ID); err != nil {
+		return nil, err
+	}
+
+	params := operations.NewPutOrganizationParamsWithContext(ctx).WithID(organization.ID).WithOrganization(organization)
+	response, err := c.client.Operations.PutOrganization(params, nil)
 	if err != nil {
 		return nil, err
 	}
 	return response.Payload, nil
 }
 
-func (c *client) Subscriptions(limit *int32) (subscriptionList []*models.Subscription, err error) {
-	defer func() {
-		// Until this issue is resolved: https://github.com/go-swagger/go-swagger/issues/1021, we need to recover from
-		// panics.
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Recovered from panic: %v", r)
-		}
-	}()
-	token, err := c.tokenFetcher.Token(c.audience)
+func (c *client) DeactivateOrganization(organizationID int32) (*models.Organization, error) {
+	return c.DeactivateOrganizationCtx(context.Background(), organizationID)
+}
+
+func (c *client) DeactivateOrganizationCtx(ctx context.Context, organizationID int32) (*models.Organization, error) {
+	organization, err := c.OrganizationCtx(ctx, organizationID)
 	if err != nil {
 		return nil, err
 	}
-	params := operations.NewGetSubscriptionsParams()
-	if limit != nil {
-		params.SetLimit(limit)
+	if organization.Active != nil && !*organization.Active {
+		return organization, nil
+	}
+	active := false
+	organization.Active = &active
+	return c.UpdateOrganizationCtx(ctx, organization)
+}
+
+// validateOrganization runs the models.Organization validator so that missing fields and length violations are reported
+// without a round trip to the api.
+func validateOrganization(organization *models.Organization) error {
+	if organization == nil {
+		return errors.New("organization: an organization is required")
+	}
+	return organization.Validate(strfmt.Default)
+}
+
+func (c *client) Subscriptions(limit *int32) (subscriptionList []*models.Subscription, err error) {
+	return c.SubscriptionsCtx(context.Background(), limit)
+}
+
+func (c *client) SubscriptionsCtx(ctx context.Context, limit *int32) (subscriptionList []*models.Subscription, err error) {
+	return c.SubscriptionsWithOptionsCtx(ctx, ListSubscriptionsOptions{Limit: limit})
+}
+
+func (c *client) SubscriptionsWithOptions(options ListSubscriptionsOptions) (subscriptionList []*models.Subscription, err error) {
+	return c.SubscriptionsWithOptionsCtx(context.Background(), options)
+}
+
+func (c *client) SubscriptionsWithOptionsCtx(ctx context.Context, options ListSubscriptionsOptions) (subscriptionList []*models.Subscription, err error) {
+	if err := options.validate(); err != nil {
+		return nil, err
+	}
+	params := operations.NewGetSubscriptionsParamsWithContext(ctx).
+		WithActive(options.Active).
+		WithLimit(options.Limit).
+		WithOffset(options.Offset).
+		WithPaymentMethod(options.PaymentMethod)
+	response, err := c.client.Operations.GetSubscriptions(params, nil)
+	if err != nil {
+		return nil, err
 	}
-	response, err := c.client.Operations.GetSubscriptions(params, openapiclient.BearerToken(token))
+	return response.Payload, nil
+}
+
+func (c *client) OrganizationSubscriptions(organizationID int32) ([]*models.Subscription, error) {
+	return c.OrganizationSubscriptionsCtx(context.Background(), organizationID)
+}
+
+func (c *client) OrganizationSubscriptionsCtx(ctx context.Context, organizationID int32) (subscriptionList []*models.Subscription, err error) {
+	params := operations.NewGetSubscriptionsByOrganizationParamsWithContext(ctx).WithOrgID(organizationID)
+	response, err := c.client.Operations.GetSubscriptionsByOrganization(params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -167,62 +491,199 @@ func (c *client) Subscriptions(limit *int32) (subscriptionList []*models.Subscri
 }
 
 func (c *client) UpdateSubscription(subscription *models.Subscription) (a *models.Subscription, err error) {
-	defer func() {
-		// Until this issue is resolved: https://github.com/go-swagger/go-swagger/issues/1021, we need to recover from
-		// panics.
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Recovered from panic: %v", r)
-		}
-	}()
-	token, err := c.tokenFetcher.Token(c.audience)
+	return c.UpdateSubscriptionCtx(context.Background(), subscription)
+}
+
+func (c *client) UpdateSubscriptionCtx(ctx context.Context, subscription *models.Subscription) (a *models.Subscription, err error) {
+	if subscription == nil {
+		return nil, errors.New("organization: a subscription is required")
+	}
+	params := operations.NewPutSubscriptionParamsWithContext(ctx).WithOrgID(subscription.OrganizationID).WithSubID(subscription.ID).WithSubscription(subscription)
+	response, err := c.client.Operations.PutSubscription(params, nil)
 	if err != nil {
 		return nil, err
 	}
-	params := operations.NewPutSubscriptionParams().WithOrgID(subscription.OrganizationID).WithSubID(subscription.ID).WithSubscription(subscription)
-	response, err := c.client.Operations.PutSubscription(params, openapiclient.BearerToken(token))
+	return response.Payload, nil
+}
+
+func (c *client) CreateSubscription(organizationID, planID int32, paymentMethod string) (*models.Subscription, error) {
+	return c.CreateSubscriptionCtx(context.Background(), organizationID, planID, paymentMethod)
+}
+
+func (c *client) CreateSubscriptionCtx(ctx context.Context, organizationID, planID int32, paymentMethod string) (created *models.Subscription, err error) {
+	subscription := newSubscription(organizationID, planID, paymentMethod)
+	if err := subscription.Validate(strfmt.Default); err != nil {
+		return nil, err
+	}
+	// The model only checks the payment method against its enum when one is set.
+	if err := validate.Required("paymentMethod", "body", paymentMethod); err != nil {
+		return nil, err
+	}
+
+	params := operations.NewAddSubscriptionParamsWithContext(ctx).WithOrgID(organizationID).WithSubscription(subscription)
+	response, err := c.client.Operations.AddSubscription(params, nil)
 	if err != nil {
 		return nil, err
 	}
 	return response.Payload, nil
 }
 
-func (c *client) Plan(planID int32) (plan *models.Plan, err error) {
-	defer func() {
-		// Until this issue is resolved: https://github.com/go-swagger/go-swagger/issues/1021, we need to recover from
-		// panics.
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Recovered from panic: %v", r)
-		}
-	}()
-	token, err := c.tokenFetcher.Token(c.audience)
+func (c *client) CancelSubscription(organizationID, subscriptionID int32, canceledBy string) (*models.Subscription, error) {
+	return c.CancelSubscriptionCtx(context.Background(), organizationID, subscriptionID, canceledBy)
+}
+
+func (c *client) CancelSubscriptionCtx(ctx context.Context, organizationID, subscriptionID int32, canceledBy string) (*models.Subscription, error) {
+	if canceledBy == "" {
+		return nil, errors.New("organization: the user cancelling the subscription is required")
+	}
+	subscription, err := c.SubscriptionCtx(ctx, organizationID, subscriptionID)
 	if err != nil {
 		return nil, err
 	}
-	params := operations.NewGetPlanParams().WithID(planID)
-	response, err := c.client.Operations.GetPlan(params, openapiclient.BearerToken(token))
+	if err := cancelSubscription(subscription, canceledBy, c.now()); err != nil {
+		return nil, err
+	}
+	if err := subscription.Validate(strfmt.Default); err != nil {
+		return nil, err
+	}
+	// UpdateSubscriptionCtx would leave out active since it is false, see operations.CancelSubscriptionParams.
+	params := operations.NewCancelSubscriptionParamsWithContext(ctx).WithOrgID(organizationID).WithSubID(subscriptionID).WithSubscription(subscription)
+	response, err := c.client.Operations.CancelSubscription(params, nil)
 	if err != nil {
 		return nil, err
 	}
 	return response.Payload, nil
 }
 
+func (c *client) ReactivateSubscription(organizationID, subscriptionID int32, reactivatedBy string) (*models.Subscription, error) {
+	return c.ReactivateSubscriptionCtx(context.Background(), organizationID, subscriptionID, reactivatedBy)
+}
+
+func (c *client) ReactivateSubscriptionCtx(ctx context.Context, organizationID, subscriptionID int32, reactivatedBy string) (*models.Subscription, error) {
+	if reactivatedBy == "" {
+		return nil, errors.New("organization: the user reactivating the subscription is required")
+	}
+	subscription, err := c.SubscriptionCtx(ctx, organizationID, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := reactivateSubscription(subscription, reactivatedBy); err != nil {
+		return nil, err
+	}
+	if err := subscription.Validate(strfmt.Default); err != nil {
+		return nil, err
+	}
+	return c.UpdateSubscriptionCtx(ctx, subscription)
+}
+
+func (c *client) Subscription(organizationID, subscriptionID int32) (*models.Subscription, error) {
+	return c.SubscriptionCtx(context.Background(), organizationID, subscriptionID)
+}
+
+func (c *client) SubscriptionCtx(ctx context.Context, organizationID, subscriptionID int32) (subscription *models.Subscription, err error) {
+	params := operations.NewGetSubscriptionParamsWithContext(ctx).WithOrgID(organizationID).WithSubID(subscriptionID)
+	response, err := c.client.Operations.GetSubscription(params, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response.Payload, nil
+}
+
+func (c *client) Plan(planID int32) (plan *models.Plan, err error) {
+	return c.PlanCtx(context.Background(), planID)
+}
+
+func (c *client) PlanCtx(ctx context.Context, planID int32) (plan *models.Plan, err error) {
+	params := operations.NewGetPlanParamsWithContext(ctx).WithID(planID)
+	response, err := c.client.Operations.GetPlan(params, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response.Payload, nil
+}
+
+func (c *client) Plans(options ListPlansOptions) ([]*models.Plan, error) {
+	return c.PlansCtx(context.Background(), options)
+}
+
+func (c *client) PlansCtx(ctx context.Context, options ListPlansOptions) (plans []*models.Plan, err error) {
+	if err := options.validate(); err != nil {
+		return nil, err
+	}
+	params := operations.NewGetPlansParamsWithContext(ctx).
+		WithAvailable(options.Available).
+		WithAllowWebSignup(options.AllowWebSignup).
+		WithPlanGroup(options.PlanGroup).
+		WithBillingInterval(options.BillingInterval).
+		WithPaymentMethod(options.PaymentMethod).
+		WithLimit(options.Limit).
+		WithOffset(options.Offset)
+	response, err := c.client.Operations.GetPlans(params, nil)
+	if err != nil {
+		return nil, err
+	}
+	return options.filter(response.Payload), nil
+}
+
 func (c *client) OrganizationUsers(organizationID int32) (users []*models.User, err error) {
-	defer func() {
-		// Until this issue is resolved: https://github.com/go-swagger/go-swagger/issues/1021, we need to recover from
-		// panics.
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Recovered from panic: %v", r)
-		}
-	}()
+	return c.OrganizationUsersCtx(context.Background(), organizationID)
+}
 
-	token, err := c.tokenFetcher.Token(c.audience)
+func (c *client) OrganizationUsersCtx(ctx context.Context, organizationID int32) (users []*models.User, err error) {
+	params := operations.NewGetUsersByOrganizationParamsWithContext(ctx).WithID(organizationID)
+	response, err := c.client.Operations.GetUsersByOrganization(params, nil)
 	if err != nil {
 		return nil, err
 	}
-	params := operations.NewGetUsersByOrganizationParams().WithID(organizationID)
-	response, err := c.client.Operations.GetUsersByOrganization(params, openapiclient.BearerToken(token))
+	return response.Payload, nil
+}
+
+func (c *client) CreateOrganizationUser(organizationID int32, user *models.UserPost) (*models.User, error) {
+	return c.CreateOrganizationUserCtx(context.Background(), organizationID, user)
+}
+
+func (c *client) CreateOrganizationUserCtx(ctx context.Context, organizationID int32, user *models.UserPost) (created *models.User, err error) {
+	if user == nil {
+		return nil, errors.New("organization: a user is required")
+	}
+	if err := user.Validate(strfmt.Default); err != nil {
+		return nil, err
+	}
+
+	params := operations.NewAddUserToOrganizationParamsWithContext(ctx).WithID(organizationID).WithUser(user)
+	response, err := c.client.Operations.AddUserToOrganization(params, nil)
+	if err != nil {
+		if apiErr, ok := err.(*APIError); ok && apiErr.StatusCode == http.StatusConflict {
+			return nil, &DuplicateEmailError{Email: user.Email.String(), Err: apiErr}
+		}
+		return nil, err
+	}
+	return response.Payload, nil
+}
+
+func (c *client) ImpersonationURL(userID string, options ImpersonationOptions) (*models.ImpersonateURL, error) {
+	return c.ImpersonationURLCtx(context.Background(), userID, options)
+}
+
+func (c *client) ImpersonationURLCtx(ctx context.Context, userID string, options ImpersonationOptions) (impersonateURL *models.ImpersonateURL, err error) {
+	if userID == "" {
+		return nil, errors.New("organization: a user id is required")
+	}
+	if err := options.validate(); err != nil {
+		return nil, err
+	}
+	// The URL itself logs in as the user, so only who asked for it, when and for whom is logged.
+	audit := []interface{}{"userID", userID, "requestedBy", options.RequestedBy, "requestedAt", c.now().UTC()}
+	if options.OrganizationID != nil {
+		audit = append(audit, "organizationID", *options.OrganizationID)
+	}
+
+	params := operations.NewImpersonateUserParamsWithContext(ctx).WithUserID(userID).WithOrganizationID(options.OrganizationID)
+	response, err := c.client.Operations.ImpersonateUser(params, nil)
 	if err != nil {
+		c.log.Warn("Impersonation URL request failed", append(audit, "err", err)...)
 		return nil, err
 	}
+	c.log.Info("Impersonation URL issued", audit...)
 	return response.Payload, nil
 }
@@ -1,16 +1,23 @@
 package organization
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/3dsim/auth0/auth0fakes"
 	"github.com/3dsim/organization-goclient/models"
+	openapierrors "github.com/go-openapi/errors"
+	openapiclient "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
@@ -21,6 +28,14 @@ const (
 	audience    = "test audience"
 )
 
+// newTestServer serves handler at path, a route relative to the api base path such as "/plans/{id}".  Requests to
+// other paths are answered with status 404.
+func newTestServer(path string, handler http.HandlerFunc) *httptest.Server {
+	r := mux.NewRouter()
+	r.HandleFunc("/"+apiBasePath+path, handler)
+	return httptest.NewServer(r)
+}
+
 func TestOrganizationsWhenSuccessfulExpectsOrganizationListReturned(t *testing.T) {
 	// arrange
 	// Token
@@ -593,3 +608,1307 @@ func TestOrganizationUsersWhenOrganizationAPIErrorsExpectsErrorReturned(t *testi
 	assert.NotNil(t, err, "Expected an error returned because organization api send a 500 error")
 	assert.Nil(t, list, "Expected list of organizations to be nil")
 }
+
+func TestOrganizationCtxWhenContextCanceledExpectsErrorAndNoAPICall(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+
+	callCounter := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		callCounter++
+		w.WriteHeader(500)
+	})
+
+	// Setup routes
+	r := mux.NewRouter()
+	r.HandleFunc("/"+apiBasePath+"/organizations/{organizationID}", handler)
+	testServer := httptest.NewServer(r)
+	defer testServer.Close()
+	client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// act
+	organization, err := client.OrganizationCtx(ctx, 1)
+
+	// assert
+	assert.Equal(t, context.Canceled, err, "Expected the context error returned")
+	assert.Nil(t, organization, "Expected organization to be nil")
+	assert.Equal(t, 0, callCounter, "Expected the organization api not to be called")
+}
+
+func TestOrganizationCtxWhenContextCanceledExpectsNoTokenFetched(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	client := NewClient(fakeTokenFetcher, "apiGatewayURL", apiBasePath, audience)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// act
+	organization, err := client.OrganizationCtx(ctx, 1)
+
+	// assert
+	assert.Equal(t, context.Canceled, err, "Expected the context error returned")
+	assert.Nil(t, organization, "Expected organization to be nil")
+	assert.Equal(t, 0, fakeTokenFetcher.TokenCallCount(), "Expected no token fetched")
+}
+
+func TestPlanCtxWhenDeadlineExceededExpectsErrorReturned(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(500 * time.Millisecond)
+		w.WriteHeader(500)
+	})
+
+	// Setup routes
+	r := mux.NewRouter()
+	r.HandleFunc("/"+apiBasePath+"/plans/{planID}", handler)
+	testServer := httptest.NewServer(r)
+	defer testServer.Close()
+	client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// act
+	start := time.Now()
+	plan, err := client.PlanCtx(ctx, 1)
+
+	// assert
+	assert.NotNil(t, err, "Expected an error returned because the context deadline was exceeded")
+	assert.Nil(t, plan, "Expected plan to be nil")
+	assert.True(t, time.Since(start) < 500*time.Millisecond, "Expected the call to return when the deadline was exceeded")
+}
+
+func TestOrganizationUsersCtxWhenTokenFetchOutlivesContextExpectsContextErrorReturned(t *testing.T) {
+	// arrange
+	release := make(chan struct{})
+	defer close(release)
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenStub = func(string) (string, error) {
+		<-release
+		return "Token", nil
+	}
+
+	client := NewClient(fakeTokenFetcher, "apiGatewayURL", apiBasePath, audience)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// act
+	list, err := client.OrganizationUsersCtx(ctx, 1)
+
+	// assert
+	assert.Equal(t, context.DeadlineExceeded, err, "Expected the context error returned")
+	assert.Nil(t, list, "Expected list of users to be nil")
+}
+
+func TestOrganizationsWithOptionsExpectsQueryStringEncoded(t *testing.T) {
+	testCases := []struct {
+		name          string
+		options       ListOrganizationsOptions
+		expectedQuery url.Values
+	}{
+		{
+			name:          "no options",
+			options:       ListOrganizationsOptions{},
+			expectedQuery: url.Values{},
+		},
+		{
+			name:          "active only",
+			options:       ListOrganizationsOptions{Active: swag.Bool(true)},
+			expectedQuery: url.Values{"active": []string{"true"}},
+		},
+		{
+			name:          "inactive only",
+			options:       ListOrganizationsOptions{Active: swag.Bool(false)},
+			expectedQuery: url.Values{"active": []string{"false"}},
+		},
+		{
+			name:          "paging",
+			options:       ListOrganizationsOptions{Limit: swag.Int32(25), Offset: swag.Int32(50)},
+			expectedQuery: url.Values{"limit": []string{"25"}, "offset": []string{"50"}},
+		},
+		{
+			name:          "zero offset is sent",
+			options:       ListOrganizationsOptions{Limit: swag.Int32(10), Offset: swag.Int32(0)},
+			expectedQuery: url.Values{"limit": []string{"10"}, "offset": []string{"0"}},
+		},
+		{
+			name:          "all options",
+			options:       ListOrganizationsOptions{Active: swag.Bool(true), Limit: swag.Int32(5), Offset: swag.Int32(15)},
+			expectedQuery: url.Values{"active": []string{"true"}, "limit": []string{"5"}, "offset": []string{"15"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// arrange
+			fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+			fakeTokenFetcher.TokenReturns("Token", nil)
+
+			var receivedQuery url.Values
+			organizationHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				receivedQuery = r.URL.Query()
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte("[]"))
+			})
+
+			// Setup routes
+			r := mux.NewRouter()
+			r.HandleFunc("/"+apiBasePath+"/organizations", organizationHandler)
+			testServer := httptest.NewServer(r)
+			defer testServer.Close()
+			client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+
+			// act
+			list, err := client.OrganizationsWithOptions(tc.options)
+
+			// assert
+			assert.Nil(t, err, "Expected no error returned")
+			assert.NotNil(t, list, "Expected returned organization list to not be nil")
+			assert.Equal(t, tc.expectedQuery, receivedQuery, "Expected query string to match the options")
+		})
+	}
+}
+
+func TestOrganizationsWithOptionsWhenOrganizationAPIErrorsExpectsErrorReturned(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+
+	organizationHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(500)
+	})
+
+	// Setup routes
+	r := mux.NewRouter()
+	r.HandleFunc("/"+apiBasePath+"/organizations", organizationHandler)
+	testServer := httptest.NewServer(r)
+	defer testServer.Close()
+	client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+
+	// act
+	list, err := client.OrganizationsWithOptions(ListOrganizationsOptions{Limit: swag.Int32(10)})
+
+	// assert
+	assert.NotNil(t, err, "Expected an error returned because organization api sent a 500 error")
+	assert.Nil(t, list, "Expected list of organizations to be nil")
+}
+
+func TestSubscriptionsWithOptionsExpectsQueryStringEncoded(t *testing.T) {
+	testCases := []struct {
+		name          string
+		options       ListSubscriptionsOptions
+		expectedQuery url.Values
+	}{
+		{
+			name:          "no options",
+			options:       ListSubscriptionsOptions{},
+			expectedQuery: url.Values{},
+		},
+		{
+			name: "active purchase order page",
+			options: ListSubscriptionsOptions{
+				Active:        swag.Bool(true),
+				Limit:         swag.Int32(100),
+				Offset:        swag.Int32(200),
+				PaymentMethod: swag.String(models.SubscriptionPaymentMethodPurchaseOrder),
+			},
+			expectedQuery: url.Values{
+				"active":        []string{"true"},
+				"limit":         []string{"100"},
+				"offset":        []string{"200"},
+				"paymentMethod": []string{"PurchaseOrder"},
+			},
+		},
+		{
+			name:          "credit card only",
+			options:       ListSubscriptionsOptions{PaymentMethod: swag.String(models.SubscriptionPaymentMethodCreditCard)},
+			expectedQuery: url.Values{"paymentMethod": []string{"CreditCard"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// arrange
+			fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+			fakeTokenFetcher.TokenReturns("Token", nil)
+
+			var receivedQuery url.Values
+			subscriptionHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				receivedQuery = r.URL.Query()
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte("[]"))
+			})
+
+			// Setup routes
+			r := mux.NewRouter()
+			r.HandleFunc("/"+apiBasePath+"/subscriptions", subscriptionHandler)
+			testServer := httptest.NewServer(r)
+			defer testServer.Close()
+			client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+
+			// act
+			list, err := client.SubscriptionsWithOptions(tc.options)
+
+			// assert
+			assert.Nil(t, err, "Expected no error returned")
+			assert.NotNil(t, list, "Expected returned subscription list to not be nil")
+			assert.Equal(t, tc.expectedQuery, receivedQuery, "Expected query string to match the options")
+		})
+	}
+}
+
+func TestSubscriptionsWithOptionsWhenPaymentMethodInvalidExpectsErrorReturned(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+
+	client := NewClient(fakeTokenFetcher, "apiGatewayURL", apiBasePath, audience)
+
+	// act
+	list, err := client.SubscriptionsWithOptions(ListSubscriptionsOptions{PaymentMethod: swag.String("Bitcoin")})
+
+	// assert
+	assert.NotNil(t, err, "Expected an error returned because the payment method is not valid")
+	assert.Nil(t, list, "Expected list of subscriptions to be nil")
+	assert.Equal(t, 0, fakeTokenFetcher.TokenCallCount(), "Expected no token to be fetched for an invalid request")
+}
+
+func TestNewClientWithTimeoutWhenClientsHaveDifferentTimeoutsExpectsEachTimeoutApplied(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	defaultTimeout := openapiclient.DefaultTimeout
+
+	planHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":1,"name":"Plan name"}`))
+	})
+
+	// Setup routes
+	r := mux.NewRouter()
+	r.HandleFunc("/"+apiBasePath+"/plans/{planID}", planHandler)
+	testServer := httptest.NewServer(r)
+	defer testServer.Close()
+	shortClient := NewClientWithTimeout(fakeTokenFetcher, testServer.URL, apiBasePath, audience, 50*time.Millisecond)
+	longClient := NewClientWithTimeout(fakeTokenFetcher, testServer.URL, apiBasePath, audience, 5*time.Second)
+	shortRetryClient := NewClientWithRetry(fakeTokenFetcher, testServer.URL, apiBasePath, audience, 50*time.Millisecond)
+
+	// act
+	_, shortErr := shortClient.Plan(1)
+	longPlan, longErr := longClient.Plan(1)
+	_, shortRetryErr := shortRetryClient.Plan(1)
+
+	// assert
+	assert.NotNil(t, shortErr, "Expected an error returned because the short timeout elapsed")
+	assert.Nil(t, longErr, "Expected no error returned because the long timeout did not elapse")
+	assert.NotNil(t, longPlan, "Expected returned plan to not be nil")
+	assert.Equal(t, defaultTimeout, openapiclient.DefaultTimeout, "Expected the global default timeout to be unchanged")
+	assert.NotNil(t, shortRetryErr, "Expected an error returned because the retry timeout is the request timeout")
+}
+
+func TestNewClientWithTimeoutWhenTimeoutZeroExpectsNoTimeout(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+
+	planHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":1,"name":"Plan name"}`))
+	})
+
+	// Setup routes
+	r := mux.NewRouter()
+	r.HandleFunc("/"+apiBasePath+"/plans/{planID}", planHandler)
+	testServer := httptest.NewServer(r)
+	defer testServer.Close()
+	client := NewClientWithTimeout(fakeTokenFetcher, testServer.URL, apiBasePath, audience, 0)
+	retryClient := NewClientWithRetry(fakeTokenFetcher, testServer.URL, apiBasePath, audience, 0)
+
+	// act
+	plan, err := client.Plan(1)
+	retryPlan, retryErr := retryClient.Plan(1)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned because a timeout of 0 means no timeout")
+	assert.NotNil(t, plan, "Expected returned plan to not be nil")
+	assert.Nil(t, retryErr, "Expected no error returned because a retry timeout of 0 means no timeout")
+	assert.NotNil(t, retryPlan, "Expected returned plan to not be nil")
+}
+
+func newUserPost() *models.UserPost {
+	email := strfmt.Email("new.user@example.com")
+	password := strfmt.Password("Password1!")
+	return &models.UserPost{
+		Email:            &email,
+		FirstName:        swag.String("New"),
+		LastName:         swag.String("User"),
+		Password:         &password,
+		Roles:            []string{"User"},
+		SendWelcomeEmail: true,
+	}
+}
+
+func TestCreateOrganizationUserWhenSuccessfulExpectsUserReturned(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	userPost := newUserPost()
+
+	testServer := newTestServer("/organizations/{id}/users", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected the user to be posted")
+		assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+		assert.Equal(t, "7", mux.Vars(r)["id"], "Expected the organization id in the path")
+		var received models.UserPost
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Error("Failed to unmarshal user post")
+		}
+		assert.Equal(t, userPost.Email.String(), received.Email.String(), "Expected emails to match")
+		assert.Equal(t, []string{"User"}, received.Roles, "Expected roles to match")
+		assert.True(t, received.SendWelcomeEmail, "Expected send welcome email to be sent")
+
+		w.Header().Set("Content-Type", "application/json")
+		bytes, err := json.Marshal(&models.User{UserID: "auth0|7", Email: received.Email.String()})
+		if err != nil {
+			t.Error("Failed to marshal user")
+		}
+		w.Write(bytes)
+	})
+	defer testServer.Close()
+	client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+
+	// act
+	user, err := client.CreateOrganizationUser(7, userPost)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	if assert.NotNil(t, user, "Expected returned user to not be nil") {
+		assert.Equal(t, "auth0|7", user.UserID, "Expected user ids to match")
+		assert.Equal(t, "new.user@example.com", user.Email, "Expected emails to match")
+	}
+}
+
+func TestCreateOrganizationUserWhenUserInvalidExpectsErrorReturnedWithoutRequest(t *testing.T) {
+	testCases := []struct {
+		name string
+		user *models.UserPost
+	}{
+		{"nil user", nil},
+		{"missing email", func() *models.UserPost { u := newUserPost(); u.Email = nil; return u }()},
+		{"missing password", func() *models.UserPost { u := newUserPost(); u.Password = nil; return u }()},
+		{"missing roles", func() *models.UserPost { u := newUserPost(); u.Roles = nil; return u }()},
+		{"unknown role", func() *models.UserPost { u := newUserPost(); u.Roles = []string{"Owner"}; return u }()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// arrange
+			fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+			fakeTokenFetcher.TokenReturns("Token", nil)
+			callCounter := 0
+			testServer := newTestServer("/organizations/{id}/users", func(w http.ResponseWriter, r *http.Request) {
+				callCounter++
+			})
+			defer testServer.Close()
+			client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+
+			// act
+			user, err := client.CreateOrganizationUser(1, tc.user)
+
+			// assert
+			assert.NotNil(t, err, "Expected a validation error returned")
+			assert.Nil(t, user, "Expected no user returned")
+			assert.Equal(t, 0, fakeTokenFetcher.TokenCallCount(), "Expected no token to be fetched")
+			assert.Equal(t, 0, callCounter, "Expected no request to be sent")
+		})
+	}
+}
+
+func TestCreateOrganizationUserWhenEmailExistsExpectsDuplicateEmailError(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	testServer := newTestServer("/organizations/{id}/users", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"code":409,"message":"user already exists"}`))
+	})
+	defer testServer.Close()
+	client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+
+	// act
+	user, err := client.CreateOrganizationUser(1, newUserPost())
+
+	// assert
+	assert.Nil(t, user, "Expected no user returned")
+	assert.True(t, IsDuplicateEmail(err), "Expected a duplicate email error")
+	assert.Equal(t, http.StatusConflict, StatusCode(err), "Expected the conflict status")
+	if duplicateErr, ok := err.(*DuplicateEmailError); assert.True(t, ok, "Expected a *DuplicateEmailError") {
+		assert.Equal(t, "new.user@example.com", duplicateErr.Email, "Expected emails to match")
+		assert.Equal(t, "user already exists", duplicateErr.Err.Message, "Expected the api message to be kept")
+	}
+}
+
+func TestCreateOrganizationUserWhenTokenFetcherErrorsExpectsErrorReturned(t *testing.T) {
+	// arrange
+	expectedError := errors.New("Some auth0 error")
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("", expectedError)
+	client := NewClient(fakeTokenFetcher, "http://localhost", apiBasePath, audience)
+
+	// act
+	user, err := client.CreateOrganizationUser(1, newUserPost())
+
+	// assert
+	assert.Nil(t, user, "Expected no user returned")
+	assert.Equal(t, expectedError, err, "Expected an error returned")
+}
+
+func TestImpersonationURLWhenSuccessfulExpectsURLReturnedAndAuditLogged(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	testServer := newTestServer("/users/{userId}/impersonate", func(w http.ResponseWriter, r *http.Request) {
+		assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+		assert.Equal(t, "auth0|5", mux.Vars(r)["userId"], "Expected the user id in the path")
+		assert.Equal(t, "3", r.URL.Query().Get("organizationId"), "Expected the organization id in the query")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"url":"https://login.example.com/impersonate?ticket=secret"}`))
+	})
+	defer testServer.Close()
+	logger, records := recordingLogger()
+	c, err := New(
+		WithTokenFetcher(fakeTokenFetcher),
+		WithAPIGatewayURL(testServer.URL),
+		WithAPIBasePath(apiBasePath),
+		WithAudience(audience),
+		WithLogger(logger),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.(*client).now = func() time.Time { return time.Date(2017, 6, 1, 12, 30, 0, 0, time.UTC) }
+
+	// act
+	impersonateURL, err := c.ImpersonationURL("auth0|5", ImpersonationOptions{
+		OrganizationID: swag.Int32(3),
+		RequestedBy:    "support@example.com",
+	})
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	if assert.NotNil(t, impersonateURL, "Expected returned impersonate url to not be nil") {
+		assert.Equal(t, "https://login.example.com/impersonate?ticket=secret", impersonateURL.URL, "Expected urls to match")
+	}
+	logged := records()
+	if assert.Len(t, logged, 1, "Expected one audit record") {
+		assert.Contains(t, logged[0], "lvl=info", "Expected the audit record at info level")
+		assert.Contains(t, logged[0], "userID=auth0|5", "Expected the impersonated user to be logged")
+		assert.Contains(t, logged[0], "requestedBy=support@example.com", "Expected the requester to be logged")
+		assert.Contains(t, logged[0], "requestedAt=2017-06-01T12:30:00", "Expected the request time to be logged")
+		assert.Contains(t, logged[0], "organizationID=3", "Expected the organization to be logged")
+		assert.NotContains(t, logged[0], "secret", "Expected the url not to be logged")
+	}
+}
+
+func TestImpersonationURLWhenRequesterMissingExpectsErrorReturnedWithoutRequest(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	callCounter := 0
+	testServer := newTestServer("/users/{userId}/impersonate", func(w http.ResponseWriter, r *http.Request) {
+		callCounter++
+	})
+	defer testServer.Close()
+	logger, records := recordingLogger()
+	client, err := New(
+		WithTokenFetcher(fakeTokenFetcher),
+		WithAPIGatewayURL(testServer.URL),
+		WithAPIBasePath(apiBasePath),
+		WithAudience(audience),
+		WithLogger(logger),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// act
+	impersonateURL, err := client.ImpersonationURL("auth0|5", ImpersonationOptions{})
+
+	// assert
+	assert.NotNil(t, err, "Expected an error returned because the requester is missing")
+	assert.Nil(t, impersonateURL, "Expected no impersonate url returned")
+	assert.Equal(t, 0, callCounter, "Expected no request to be sent")
+	assert.Empty(t, records(), "Expected nothing logged")
+}
+
+func TestImpersonationURLWhenOrganizationAPIErrorsExpectsErrorReturnedAndFailureLogged(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	testServer := newTestServer("/users/{userId}/impersonate", func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.URL.Query().Get("organizationId"), "Expected no organization id in the query")
+		w.WriteHeader(http.StatusForbidden)
+	})
+	defer testServer.Close()
+	logger, records := recordingLogger()
+	client, err := New(
+		WithTokenFetcher(fakeTokenFetcher),
+		WithAPIGatewayURL(testServer.URL),
+		WithAPIBasePath(apiBasePath),
+		WithAudience(audience),
+		WithLogger(logger),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// act
+	impersonateURL, err := client.ImpersonationURL("auth0|5", ImpersonationOptions{RequestedBy: "support@example.com"})
+
+	// assert
+	assert.True(t, IsForbidden(err), "Expected a forbidden error returned")
+	assert.Nil(t, impersonateURL, "Expected no impersonate url returned")
+	logged := records()
+	if assert.Len(t, logged, 1, "Expected one audit record") {
+		assert.Contains(t, logged[0], "lvl=warn", "Expected the failed request at warn level")
+		assert.Contains(t, logged[0], "requestedBy=support@example.com", "Expected the requester to be logged")
+	}
+}
+
+func newValidOrganization() *models.Organization {
+	return &models.Organization{
+		ID:                     5,
+		Active:                 swag.Bool(true),
+		AddressLine1:           swag.String("1 Main Street"),
+		City:                   swag.String("Durham"),
+		Country:                swag.String("USA"),
+		FreeTrialHours:         swag.Int32(10),
+		Name:                   swag.String("Organization name"),
+		PostalCode:             swag.String("27701"),
+		RunningSimulationLimit: swag.Int32(2),
+		SaasAgreementAccepted:  swag.Bool(true),
+		State:                  swag.String("NC"),
+	}
+}
+
+func TestCreateOrganizationWhenSuccessfulExpectsOrganizationReturned(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	organization := newValidOrganization()
+	organization.ID = 0
+
+	organizationHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+		var received models.Organization
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Error("Failed to unmarshal organization")
+		}
+		assert.Equal(t, "Organization name", *received.Name, "Expected names to match")
+		received.ID = 9
+		w.Header().Set("Content-Type", "application/json")
+		bytes, err := json.Marshal(received)
+		if err != nil {
+			t.Error("Failed to marshal organization")
+		}
+		w.Write(bytes)
+	})
+
+	// Setup routes
+	r := mux.NewRouter()
+	r.HandleFunc("/"+apiBasePath+"/organizations", organizationHandler).Methods(http.MethodPost)
+	testServer := httptest.NewServer(r)
+	defer testServer.Close()
+	client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+
+	// act
+	created, err := client.CreateOrganization(organization)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	if assert.NotNil(t, created, "Expected returned organization to not be nil") {
+		assert.Equal(t, int32(9), created.ID, "Expected the id assigned by the api")
+	}
+}
+
+func TestCreateAndUpdateOrganizationWhenInvalidExpectsErrorReturnedWithoutRequest(t *testing.T) {
+	testCases := []struct {
+		name   string
+		modify func(*models.Organization) *models.Organization
+	}{
+		{"nil organization", func(o *models.Organization) *models.Organization { return nil }},
+		{"name too long", func(o *models.Organization) *models.Organization {
+			o.Name = swag.String(strings.Repeat("n", 101))
+			return o
+		}},
+		{"address line 3 too long", func(o *models.Organization) *models.Organization {
+			o.AddressLine3 = swag.String(strings.Repeat("a", 211))
+			return o
+		}},
+		{"city too long", func(o *models.Organization) *models.Organization {
+			o.City = swag.String(strings.Repeat("c", 51))
+			return o
+		}},
+		{"postal code too long", func(o *models.Organization) *models.Organization {
+			o.PostalCode = swag.String(strings.Repeat("1", 51))
+			return o
+		}},
+		{"missing name", func(o *models.Organization) *models.Organization {
+			o.Name = nil
+			return o
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// arrange
+			fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+			fakeTokenFetcher.TokenReturns("Token", nil)
+			callCounter := 0
+			r := mux.NewRouter()
+			r.PathPrefix("/" + apiBasePath).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				callCounter++
+			})
+			testServer := httptest.NewServer(r)
+			defer testServer.Close()
+			client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+
+			// act
+			created, createErr := client.CreateOrganization(tc.modify(newValidOrganization()))
+			updated, updateErr := client.UpdateOrganization(tc.modify(newValidOrganization()))
+
+			// assert
+			assert.NotNil(t, createErr, "Expected a validation error returned from create")
+			assert.NotNil(t, updateErr, "Expected a validation error returned from update")
+			assert.Nil(t, created, "Expected no organization returned from create")
+			assert.Nil(t, updated, "Expected no organization returned from update")
+			assert.Equal(t, 0, callCounter, "Expected no request to be sent")
+		})
+	}
+}
+
+func TestUpdateOrganizationWhenSuccessfulExpectsOrganizationReturned(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	organization := newValidOrganization()
+	organization.Name = swag.String("New name")
+
+	organizationHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var received models.Organization
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Error("Failed to unmarshal organization")
+		}
+		assert.Equal(t, "New name", *received.Name, "Expected names to match")
+		bytes, err := json.Marshal(received)
+		if err != nil {
+			t.Error("Failed to marshal organization")
+		}
+		w.Write(bytes)
+	})
+
+	// Setup routes
+	r := mux.NewRouter()
+	r.HandleFunc("/"+apiBasePath+"/organizations/5", organizationHandler).Methods(http.MethodPut)
+	testServer := httptest.NewServer(r)
+	defer testServer.Close()
+	client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+
+	// act
+	updated, err := client.UpdateOrganization(organization)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	if assert.NotNil(t, updated, "Expected returned organization to not be nil") {
+		assert.Equal(t, "New name", *updated.Name, "Expected names to match")
+	}
+}
+
+func TestUpdateOrganizationWhenIDMissingExpectsValidationErrorWithoutRequest(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	organization := newValidOrganization()
+	organization.ID = 0
+	callCounter := 0
+	r := mux.NewRouter()
+	r.PathPrefix("/" + apiBasePath).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		callCounter++
+	})
+	testServer := httptest.NewServer(r)
+	defer testServer.Close()
+	client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+
+	// act
+	updated, err := client.UpdateOrganization(organization)
+
+	// assert
+	assert.Nil(t, updated, "Expected no organization returned")
+	if assert.IsType(t, &openapierrors.Validation{}, err, "Expected a validation error returned") {
+		assert.Equal(t, "id", err.(*openapierrors.Validation).Name, "Expected the id to be reported missing")
+	}
+	assert.Equal(t, 0, callCounter, "Expected no request to be sent")
+}
+
+func TestDeactivateOrganizationWhenActiveExpectsOrganizationUpdatedInactive(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	organization := newValidOrganization()
+	putCounter := 0
+	testServer := newTestServer("/organizations/5", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodPut {
+			bytes, err := json.Marshal(organization)
+			if err != nil {
+				t.Error("Failed to marshal organization")
+			}
+			w.Write(bytes)
+			return
+		}
+		putCounter++
+		var received models.Organization
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Error("Failed to unmarshal organization")
+		}
+		assert.False(t, *received.Active, "Expected the organization to be sent inactive")
+		assert.Equal(t, *organization.Name, *received.Name, "Expected the other fields to be kept")
+		bytes, err := json.Marshal(received)
+		if err != nil {
+			t.Error("Failed to marshal organization")
+		}
+		w.Write(bytes)
+	})
+	defer testServer.Close()
+	client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+
+	// act
+	deactivated, err := client.DeactivateOrganization(5)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	assert.Equal(t, 1, putCounter, "Expected the organization to be updated")
+	if assert.NotNil(t, deactivated, "Expected returned organization to not be nil") {
+		assert.False(t, *deactivated.Active, "Expected the organization to be inactive")
+	}
+}
+
+func TestDeactivateOrganizationWhenAlreadyInactiveExpectsNoUpdate(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	organization := newValidOrganization()
+	organization.Active = swag.Bool(false)
+	putCounter := 0
+	testServer := newTestServer("/organizations/5", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			putCounter++
+		}
+		w.Header().Set("Content-Type", "application/json")
+		bytes, err := json.Marshal(organization)
+		if err != nil {
+			t.Error("Failed to marshal organization")
+		}
+		w.Write(bytes)
+	})
+	defer testServer.Close()
+	client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+
+	// act
+	deactivated, err := client.DeactivateOrganization(5)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	assert.Equal(t, 0, putCounter, "Expected no update to be sent")
+	if assert.NotNil(t, deactivated, "Expected returned organization to not be nil") {
+		assert.False(t, *deactivated.Active, "Expected the organization to be inactive")
+	}
+}
+
+func TestDeactivateOrganizationWhenNotFoundExpectsErrorReturned(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	r := mux.NewRouter()
+	r.HandleFunc("/"+apiBasePath+"/organizations/5", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	testServer := httptest.NewServer(r)
+	defer testServer.Close()
+	client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+
+	// act
+	deactivated, err := client.DeactivateOrganization(5)
+
+	// assert
+	assert.True(t, IsNotFound(err), "Expected a not found error returned")
+	assert.Nil(t, deactivated, "Expected no organization returned")
+}
+
+func TestCreateSubscriptionWhenSuccessfulExpectsSubscriptionReturned(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+
+	subscriptionHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+		var received models.Subscription
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Error("Failed to unmarshal subscription")
+		}
+		assert.Equal(t, int32(1), received.OrganizationID, "Expected organization ids to match")
+		assert.Equal(t, int32(4), received.PlanID, "Expected plan ids to match")
+		assert.Equal(t, models.SubscriptionPaymentMethodPurchaseOrder, received.PaymentMethod, "Expected payment methods to match")
+		assert.True(t, received.Active, "Expected the subscription to be active")
+		received.ID = 2
+		w.Header().Set("Content-Type", "application/json")
+		bytes, err := json.Marshal(received)
+		if err != nil {
+			t.Error("Failed to marshal subscription")
+		}
+		w.Write(bytes)
+	})
+
+	// Setup routes
+	r := mux.NewRouter()
+	r.HandleFunc("/"+apiBasePath+"/organizations/1/subscriptions", subscriptionHandler).Methods(http.MethodPost)
+	testServer := httptest.NewServer(r)
+	defer testServer.Close()
+	client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+
+	// act
+	subscription, err := client.CreateSubscription(1, 4, models.SubscriptionPaymentMethodPurchaseOrder)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	if assert.NotNil(t, subscription, "Expected returned subscription to not be nil") {
+		assert.Equal(t, int32(2), subscription.ID, "Expected the id assigned by the api")
+	}
+}
+
+func TestCreateSubscriptionWhenPaymentMethodInvalidExpectsErrorReturned(t *testing.T) {
+	testCases := []struct {
+		name          string
+		paymentMethod string
+	}{
+		{"unknown payment method", "Cash"},
+		{"missing payment method", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// arrange
+			fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+			fakeTokenFetcher.TokenReturns("Token", nil)
+			client := NewClient(fakeTokenFetcher, "http://localhost", apiBasePath, audience)
+
+			// act
+			subscription, err := client.CreateSubscription(1, 4, tc.paymentMethod)
+
+			// assert
+			assert.NotNil(t, err, "Expected a validation error returned")
+			assert.Nil(t, subscription, "Expected no subscription returned")
+			assert.Equal(t, 0, fakeTokenFetcher.TokenCallCount(), "Expected no token to be fetched")
+		})
+	}
+}
+
+func TestCancelSubscriptionWhenActiveExpectsCanceledSubscriptionSent(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	var body []byte
+	testServer := newTestServer("/organizations/1/subscriptions/2", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodPut {
+			w.Write([]byte(`{"id":2,"organizationId":1,"planId":4,"active":true}`))
+			return
+		}
+		var err error
+		if body, err = ioutil.ReadAll(r.Body); err != nil {
+			t.Error("Failed to read subscription")
+		}
+		w.Write(body)
+	})
+	defer testServer.Close()
+	c := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+	c.(*client).now = func() time.Time { return time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC) }
+
+	// act
+	subscription, err := c.CancelSubscription(1, 2, "admin@example.com")
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	assert.NotNil(t, subscription, "Expected returned subscription to not be nil")
+	var raw map[string]interface{}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		t.Fatalf("Failed to unmarshal the sent subscription %q: %v", body, err)
+	}
+	assert.Equal(t, false, raw["active"], "Expected active to be sent as false")
+	var sent *models.Subscription
+	if err := json.Unmarshal(body, &sent); err != nil {
+		t.Fatal(err)
+	}
+	if assert.NotNil(t, sent, "Expected the subscription to be updated") {
+		assert.Equal(t, "admin@example.com", sent.CanceledBy, "Expected canceled by to be sent")
+		assert.Equal(t, int32(4), sent.PlanID, "Expected the other fields to be kept")
+		if assert.NotNil(t, sent.CanceledAt, "Expected canceled at to be sent") {
+			assert.Equal(t, "2017-06-01T12:00:00.000Z", sent.CanceledAt.String(), "Expected canceled at to be now")
+		}
+	}
+}
+
+func TestReactivateSubscriptionWhenCanceledExpectsActiveSubscriptionSent(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	var sent *models.Subscription
+	testServer := newTestServer("/organizations/1/subscriptions/2", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		subscription := canceledSubscription()
+		if r.Method == http.MethodPut {
+			if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+				t.Error("Failed to unmarshal subscription")
+			}
+			subscription = sent
+		}
+		bytes, err := json.Marshal(subscription)
+		if err != nil {
+			t.Error("Failed to marshal subscription")
+		}
+		w.Write(bytes)
+	})
+	defer testServer.Close()
+	client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+
+	// act
+	subscription, err := client.ReactivateSubscription(1, 2, "admin@example.com")
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	assert.NotNil(t, subscription, "Expected returned subscription to not be nil")
+	if assert.NotNil(t, sent, "Expected the subscription to be updated") {
+		assert.True(t, sent.Active, "Expected the subscription to be sent active")
+		assert.Nil(t, sent.CanceledAt, "Expected canceled at to be cleared")
+		assert.Empty(t, sent.CanceledBy, "Expected canceled by to be cleared")
+	}
+}
+
+func TestCancelAndReactivateSubscriptionWhenTransitionInvalidExpectsErrorWithoutUpdate(t *testing.T) {
+	testCases := []struct {
+		name                    string
+		subscription            *models.Subscription
+		transition              func(Client) (*models.Subscription, error)
+		expectedTransitionError bool
+	}{
+		{"cancel when already canceled", canceledSubscription(), func(c Client) (*models.Subscription, error) {
+			return c.CancelSubscription(1, 2, "admin@example.com")
+		}, true},
+		{"cancel without canceled by", &models.Subscription{ID: 2, OrganizationID: 1, Active: true}, func(c Client) (*models.Subscription, error) {
+			return c.CancelSubscription(1, 2, "")
+		}, false},
+		{"reactivate when active", &models.Subscription{ID: 2, OrganizationID: 1, Active: true}, func(c Client) (*models.Subscription, error) {
+			return c.ReactivateSubscription(1, 2, "admin@example.com")
+		}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// arrange
+			fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+			fakeTokenFetcher.TokenReturns("Token", nil)
+			putCounter := 0
+			testServer := newTestServer("/organizations/1/subscriptions/2", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPut {
+					putCounter++
+				}
+				w.Header().Set("Content-Type", "application/json")
+				bytes, err := json.Marshal(tc.subscription)
+				if err != nil {
+					t.Error("Failed to marshal subscription")
+				}
+				w.Write(bytes)
+			})
+			defer testServer.Close()
+			client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+
+			// act
+			subscription, err := tc.transition(client)
+
+			// assert
+			assert.NotNil(t, err, "Expected an error returned")
+			assert.Equal(t, tc.expectedTransitionError, IsSubscriptionTransitionError(err), "Expected the kind of error to match")
+			assert.Nil(t, subscription, "Expected no subscription returned")
+			assert.Equal(t, 0, putCounter, "Expected no update to be sent")
+		})
+	}
+}
+
+func TestPlansExpectsQueryStringEncoded(t *testing.T) {
+	testCases := []struct {
+		name          string
+		options       ListPlansOptions
+		plans         string
+		expectedQuery url.Values
+	}{
+		{
+			name:          "no options",
+			options:       ListPlansOptions{},
+			plans:         `[{"id":1,"name":"Plan name"},{"id":2,"name":"Other plan"}]`,
+			expectedQuery: url.Values{},
+		},
+		{
+			name: "web signup plans in a group",
+			options: ListPlansOptions{
+				Available:       swag.Bool(true),
+				AllowWebSignup:  swag.Bool(true),
+				PlanGroup:       swag.String("Additive"),
+				BillingInterval: swag.String(models.PlanBillingIntervalYearly),
+				PaymentMethod:   swag.String(models.PlanPaymentMethodCreditCard),
+				Limit:           swag.Int32(20),
+				Offset:          swag.Int32(40),
+			},
+			plans: `[{"id":1,"name":"Plan name","available":true,"allowWebSignup":true,"planGroup":"Additive",` +
+				`"billingInterval":"Yearly","paymentMethod":"CreditCard"},{"id":2,"name":"Other plan","available":true,` +
+				`"allowWebSignup":true,"planGroup":"Additive","billingInterval":"Yearly","paymentMethod":"CreditCard"}]`,
+			expectedQuery: url.Values{
+				"available":       []string{"true"},
+				"allowWebSignup":  []string{"true"},
+				"planGroup":       []string{"Additive"},
+				"billingInterval": []string{"Yearly"},
+				"paymentMethod":   []string{"CreditCard"},
+				"limit":           []string{"20"},
+				"offset":          []string{"40"},
+			},
+		},
+		{
+			name:          "unavailable plans",
+			options:       ListPlansOptions{Available: swag.Bool(false)},
+			plans:         `[{"id":1,"name":"Plan name"},{"id":2,"name":"Other plan"}]`,
+			expectedQuery: url.Values{"available": []string{"false"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// arrange
+			fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+			fakeTokenFetcher.TokenReturns("Token", nil)
+
+			var receivedQuery url.Values
+			plansHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+				receivedQuery = r.URL.Query()
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(tc.plans))
+			})
+
+			// Setup routes
+			r := mux.NewRouter()
+			r.HandleFunc("/"+apiBasePath+"/plans", plansHandler)
+			testServer := httptest.NewServer(r)
+			defer testServer.Close()
+			client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+
+			// act
+			plans, err := client.Plans(tc.options)
+
+			// assert
+			assert.Nil(t, err, "Expected no error returned")
+			assert.Len(t, plans, 2, "Expected both plans returned")
+			assert.Equal(t, tc.expectedQuery, receivedQuery, "Expected query string to match the options")
+		})
+	}
+}
+
+func TestPlansWhenAPIIgnoresOptionsExpectsPlansFilteredByClient(t *testing.T) {
+	testCases := []struct {
+		name        string
+		options     ListPlansOptions
+		expectedIDs []int32
+	}{
+		{"no options", ListPlansOptions{}, []int32{1, 2, 3, 4}},
+		{"available", ListPlansOptions{Available: swag.Bool(true)}, []int32{1, 2, 3}},
+		{"unavailable", ListPlansOptions{Available: swag.Bool(false)}, []int32{4}},
+		{"web signup", ListPlansOptions{AllowWebSignup: swag.Bool(true)}, []int32{1, 3}},
+		{"plan group", ListPlansOptions{PlanGroup: swag.String("Additive")}, []int32{1, 2}},
+		{"billing interval", ListPlansOptions{BillingInterval: swag.String(models.PlanBillingIntervalYearly)}, []int32{2}},
+		{"payment method", ListPlansOptions{PaymentMethod: swag.String(models.PlanPaymentMethodPurchaseOrder)}, []int32{2, 4}},
+		{"web signup in a group", ListPlansOptions{AllowWebSignup: swag.Bool(true), PlanGroup: swag.String("Additive")}, []int32{1}},
+		{"limit", ListPlansOptions{Available: swag.Bool(true), Limit: swag.Int32(2)}, []int32{1, 2}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// arrange
+			fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+			fakeTokenFetcher.TokenReturns("Token", nil)
+			testServer := newTestServer("/plans", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode([]*models.Plan{
+					{ID: 1, Name: swag.String("Additive web"), Available: true, AllowWebSignup: true, PlanGroup: "Additive",
+						BillingInterval: models.PlanBillingIntervalMonthly, PaymentMethod: models.PlanPaymentMethodCreditCard},
+					{ID: 2, Name: swag.String("Additive enterprise"), Available: true, PlanGroup: "Additive",
+						BillingInterval: models.PlanBillingIntervalYearly, PaymentMethod: models.PlanPaymentMethodPurchaseOrder},
+					{ID: 3, Name: swag.String("Thermal web"), Available: true, AllowWebSignup: true, PlanGroup: "Thermal",
+						BillingInterval: models.PlanBillingIntervalMonthly, PaymentMethod: models.PlanPaymentMethodCreditCard},
+					{ID: 4, Name: swag.String("Retired"), PlanGroup: "Thermal",
+						BillingInterval: models.PlanBillingIntervalMonthly, PaymentMethod: models.PlanPaymentMethodPurchaseOrder},
+				})
+			})
+			defer testServer.Close()
+			client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+
+			// act
+			plans, err := client.Plans(tc.options)
+
+			// assert
+			assert.Nil(t, err, "Expected no error returned")
+			ids := []int32{}
+			for _, plan := range plans {
+				ids = append(ids, plan.ID)
+			}
+			assert.Equal(t, tc.expectedIDs, ids, "Expected only the plans matching the options")
+		})
+	}
+}
+
+func TestPlansWhenEnumInvalidExpectsErrorReturned(t *testing.T) {
+	testCases := []struct {
+		name    string
+		options ListPlansOptions
+	}{
+		{"billing interval", ListPlansOptions{BillingInterval: swag.String("Weekly")}},
+		{"payment method", ListPlansOptions{PaymentMethod: swag.String("Bitcoin")}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// arrange
+			fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+			fakeTokenFetcher.TokenReturns("Token", nil)
+			client := NewClient(fakeTokenFetcher, "http://localhost", apiBasePath, audience)
+
+			// act
+			plans, err := client.Plans(tc.options)
+
+			// assert
+			assert.NotNil(t, err, "Expected an error returned because the option is not valid")
+			assert.Nil(t, plans, "Expected list of plans to be nil")
+			assert.Equal(t, 0, fakeTokenFetcher.TokenCallCount(), "Expected no token to be fetched for an invalid request")
+		})
+	}
+}
+
+func TestOrganizationSubscriptionsWhenSuccessfulExpectsSubscriptionListReturned(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+
+	listToReturn := []*models.Subscription{
+		&models.Subscription{ID: 1, OrganizationID: 7},
+		&models.Subscription{ID: 2, OrganizationID: 7},
+	}
+
+	subscriptionHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+		bytes, err := json.Marshal(listToReturn)
+		if err != nil {
+			t.Error("Failed to marshal subscription list")
+		}
+		w.Write(bytes)
+	})
+
+	// Setup routes
+	r := mux.NewRouter()
+	r.HandleFunc("/"+apiBasePath+"/organizations/7/subscriptions", subscriptionHandler).Methods(http.MethodGet)
+	testServer := httptest.NewServer(r)
+	defer testServer.Close()
+	client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+
+	// act
+	list, err := client.OrganizationSubscriptions(7)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	assert.Len(t, list, len(listToReturn), "Expected list lengths to match")
+	for _, subscription := range list {
+		assert.Equal(t, int32(7), subscription.OrganizationID, "Expected only subscriptions of the organization")
+	}
+}
+
+func TestOrganizationSubscriptionsWhenTokenFetcherErrorsExpectsErrorReturned(t *testing.T) {
+	// arrange
+	expectedError := errors.New("Some auth0 error")
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("", expectedError)
+	client := NewClient(fakeTokenFetcher, "http://localhost", apiBasePath, audience)
+
+	// act
+	list, err := client.OrganizationSubscriptions(7)
+
+	// assert
+	assert.Nil(t, list, "Expected list of subscriptions to be nil")
+	assert.Equal(t, expectedError, err, "Expected an error returned")
+}
+
+func TestSubscriptionWhenSuccessfulExpectsSubscriptionReturned(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+
+	subscriptionHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+		w.Write([]byte(`{"id":2,"organizationId":7,"planId":4}`))
+	})
+
+	// Setup routes
+	r := mux.NewRouter()
+	r.HandleFunc("/"+apiBasePath+"/organizations/7/subscriptions/2", subscriptionHandler).Methods(http.MethodGet)
+	testServer := httptest.NewServer(r)
+	defer testServer.Close()
+	client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+
+	// act
+	subscription, err := client.Subscription(7, 2)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	if assert.NotNil(t, subscription, "Expected returned subscription to not be nil") {
+		assert.Equal(t, int32(2), subscription.ID, "Expected IDs to match")
+		assert.Equal(t, int32(4), subscription.PlanID, "Expected plan IDs to match")
+	}
+}
+
+func TestSubscriptionWhenNotFoundExpectsNotFoundErrorReturned(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+
+	// Setup routes
+	r := mux.NewRouter()
+	r.HandleFunc("/"+apiBasePath+"/organizations/7/subscriptions/2", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	testServer := httptest.NewServer(r)
+	defer testServer.Close()
+	client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+
+	// act
+	subscription, err := client.Subscription(7, 2)
+
+	// assert
+	assert.True(t, IsNotFound(err), "Expected a not found error returned")
+	assert.Nil(t, subscription, "Expected no subscription returned")
+}
@@ -0,0 +1,133 @@
+package organization
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// Credentials authenticate the requests of a client.  Use WithCredentials to authenticate with something other than a
+// token fetcher, e.g. StaticToken, TokenFile or APIKey.
+type Credentials interface {
+	// AuthInfo returns the auth info writer for one request.  It is called once per request, and again if the request
+	// is retried because the api responded with status 401.
+	AuthInfo(ctx context.Context) (runtime.ClientAuthInfoWriter, error)
+}
+
+// RefreshableCredentials are Credentials whose auth info can go stale, e.g. a token that expires.  When the api
+// responds with status 401, the client calls Invalidate with the rejected auth info and retries the request once with
+// the auth info returned by the next call to AuthInfo.
+type RefreshableCredentials interface {
+	Credentials
+	Invalidate(rejected runtime.ClientAuthInfoWriter)
+}
+
+// WithCredentials sets the credentials used to authenticate requests instead of a token fetcher.  The audience is only
+// required with a token fetcher.
+func WithCredentials(credentials Credentials) Option {
+	return func(cfg *config) {
+		cfg.credentials = credentials
+	}
+}
+
+// StaticToken returns Credentials that authenticate every request with the bearer token token.  The token is never
+// refreshed, so requests rejected with status 401 are not retried.
+func StaticToken(token string) Credentials {
+	return staticCredentials{bearer(token)}
+}
+
+// APIKey returns Credentials that send key in header, for APIs on the Tyk gateway that use auth tokens instead of
+// JWTs.  Tyk reads the key from the Authorization header unless the API defines another auth header; an empty header
+// uses Authorization.
+func APIKey(header, key string) Credentials {
+	if header == "" {
+		header = "Authorization"
+	}
+	return staticCredentials{apiKey{header: header, key: key}}
+}
+
+type staticCredentials struct {
+	authInfo runtime.ClientAuthInfoWriter
+}
+
+func (c staticCredentials) AuthInfo(context.Context) (runtime.ClientAuthInfoWriter, error) {
+	return c.authInfo, nil
+}
+
+type apiKey struct {
+	header string
+	key    string
+}
+
+func (k apiKey) AuthenticateRequest(request runtime.ClientRequest, _ strfmt.Registry) error {
+	return request.SetHeaderParam(k.header, k.key)
+}
+
+// TokenFile returns Credentials that authenticate requests with the bearer token read from the file at path, with
+// surrounding white space removed.  The file is read again whenever its modification time or size changes, so a token
+// rotated on disk, e.g. a mounted secret, is picked up without restarting.  It is also read again after the api
+// rejects the token with status 401.
+func TokenFile(path string) RefreshableCredentials {
+	return &tokenFileCredentials{path: path}
+}
+
+type tokenFileCredentials struct {
+	path string
+
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+	size    int64
+}
+
+func (c *tokenFileCredentials) AuthInfo(context.Context) (runtime.ClientAuthInfoWriter, error) {
+	info, err := os.Stat(c.path)
+	if err != nil {
+		return nil, fmt.Errorf("organization: reading token file: %v", err)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.token != "" && info.ModTime().Equal(c.modTime) && info.Size() == c.size {
+		return bearer(c.token), nil
+	}
+	contents, err := ioutil.ReadFile(c.path)
+	if err != nil {
+		return nil, fmt.Errorf("organization: reading token file: %v", err)
+	}
+	token := strings.TrimSpace(string(contents))
+	if token == "" {
+		return nil, fmt.Errorf("organization: token file %q is empty", c.path)
+	}
+	c.token, c.modTime, c.size = token, info.ModTime(), info.Size()
+	return bearer(c.token), nil
+}
+
+func (c *tokenFileCredentials) Invalidate(rejected runtime.ClientAuthInfoWriter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if token, ok := rejected.(bearer); ok && string(token) == c.token {
+		c.token = ""
+	}
+}
+
+// bearer authenticates a request with a bearer token.  Unlike openapiclient.BearerToken it keeps the token, so that
+// credentials know which token the api rejected.
+type bearer string
+
+func (b bearer) AuthenticateRequest(request runtime.ClientRequest, _ strfmt.Registry) error {
+	return request.SetHeaderParam("Authorization", "Bearer "+string(b))
+}
+
+// sameToken reports whether a and b send the same bearer token, in which case retrying is pointless.
+func sameToken(a, b runtime.ClientAuthInfoWriter) bool {
+	tokenA, okA := a.(bearer)
+	tokenB, okB := b.(bearer)
+	return okA && okB && tokenA == tokenB
+}
@@ -0,0 +1,161 @@
+package organization
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeTokenFile(t *testing.T, path, token string, modTime time.Time) {
+	if err := ioutil.WriteFile(path, []byte(token+"\n"), 0600); err != nil {
+		t.Fatalf("Failed to write token file: %v", err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatalf("Failed to set token file time: %v", err)
+	}
+}
+
+func TestStaticTokenExpectsBearerTokenAndNoRetry(t *testing.T) {
+	// arrange
+	var accepted atomic.Value
+	accepted.Store("Bearer static")
+	var requests int32
+	testServer := newTestServer("/plans/{id}", authenticatedPlanHandler("Authorization", &accepted, &requests))
+	defer testServer.Close()
+	client, err := New(WithCredentials(StaticToken("static")), WithAPIGatewayURL(testServer.URL), WithAPIBasePath(apiBasePath))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// act
+	plan, err := client.Plan(1)
+	accepted.Store("Bearer other")
+	_, unauthorizedErr := client.Plan(1)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	assert.Equal(t, int32(1), plan.ID, "Expected the plan")
+	assert.True(t, IsUnauthorized(unauthorizedErr), "Expected an unauthorized error")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests), "Expected a static token not to be retried")
+}
+
+func TestAPIKeyExpectsKeyInHeader(t *testing.T) {
+	testCases := []struct {
+		name   string
+		header string
+		sentIn string
+	}{
+		{"default header", "", "Authorization"},
+		{"custom header", "X-Api-Key", "X-Api-Key"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// arrange
+			var accepted atomic.Value
+			accepted.Store("tyk-key")
+			var requests int32
+			testServer := newTestServer("/plans/{id}", authenticatedPlanHandler(tc.sentIn, &accepted, &requests))
+			defer testServer.Close()
+			client, err := New(WithCredentials(APIKey(tc.header, "tyk-key")), WithAPIGatewayURL(testServer.URL), WithAPIBasePath(apiBasePath))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// act
+			_, err = client.Plan(1)
+
+			// assert
+			assert.Nil(t, err, "Expected the key to be accepted")
+		})
+	}
+}
+
+func TestTokenFileWhenFileRotatedExpectsNewToken(t *testing.T) {
+	// arrange
+	dir, _ := ioutil.TempDir("", "token-file")
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "token")
+	modTime := time.Now().Add(-time.Hour)
+	writeTokenFile(t, path, "first", modTime)
+	var accepted atomic.Value
+	accepted.Store("Bearer first")
+	var requests int32
+	testServer := newTestServer("/plans/{id}", authenticatedPlanHandler("Authorization", &accepted, &requests))
+	defer testServer.Close()
+	client, err := New(WithCredentials(TokenFile(path)), WithAPIGatewayURL(testServer.URL), WithAPIBasePath(apiBasePath))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// act
+	_, firstErr := client.Plan(1)
+	writeTokenFile(t, path, "second", modTime.Add(time.Minute))
+	accepted.Store("Bearer second")
+	_, secondErr := client.Plan(1)
+
+	// assert
+	assert.Nil(t, firstErr, "Expected the first token to be accepted")
+	assert.Nil(t, secondErr, "Expected the rotated token to be read")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests), "Expected no retries")
+}
+
+func TestTokenFileWhenTokenRejectedExpectsFileReadAgain(t *testing.T) {
+	// arrange
+	dir, _ := ioutil.TempDir("", "token-file")
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "token")
+	modTime := time.Now().Add(-time.Hour)
+	writeTokenFile(t, path, "aaaa", modTime)
+	var accepted atomic.Value
+	accepted.Store("Bearer aaaa")
+	var requests int32
+	testServer := newTestServer("/plans/{id}", authenticatedPlanHandler("Authorization", &accepted, &requests))
+	defer testServer.Close()
+	client, err := New(WithCredentials(TokenFile(path)), WithAPIGatewayURL(testServer.URL), WithAPIBasePath(apiBasePath))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.Plan(1)
+	// The rotation keeps the size and modification time so that only the 401 reveals it.
+	writeTokenFile(t, path, "bbbb", modTime)
+	accepted.Store("Bearer bbbb")
+
+	// act
+	_, err = client.Plan(1)
+
+	// assert
+	assert.Nil(t, err, "Expected the request to be retried with the token read again")
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests), "Expected one retry")
+}
+
+func TestTokenFileWhenFileMissingOrEmptyExpectsError(t *testing.T) {
+	// arrange
+	dir, _ := ioutil.TempDir("", "token-file")
+	defer os.RemoveAll(dir)
+	empty := filepath.Join(dir, "empty")
+	writeTokenFile(t, empty, "  ", time.Now())
+
+	for _, path := range []string{filepath.Join(dir, "missing"), empty} {
+		// act
+		_, err := TokenFile(path).AuthInfo(context.Background())
+
+		// assert
+		assert.NotNil(t, err, "Expected an error for "+path)
+	}
+}
+
+func TestNewWhenCredentialsWithoutTokenFetcherExpectsClient(t *testing.T) {
+	// act
+	client, err := New(WithCredentials(StaticToken("static")), WithAPIGatewayURL("https://3dsim.cloud.tyk.io"))
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	assert.NotNil(t, client, "Expected a client")
+}
@@ -0,0 +1,134 @@
+package organization
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	log "github.com/inconshreveable/log15"
+)
+
+// redacted replaces sensitive values in debug logs.
+const redacted = "[REDACTED]"
+
+// redactedHeaders are the headers whose values are never logged.
+var redactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// redactedFields are the JSON fields of models.User, models.UserPost, and of any other body, whose values are never
+// logged because they are secret or identify a person.  The url of models.ImpersonateURL logs in as the user.
+var redactedFields = map[string]bool{
+	"email":         true,
+	"firstName":     true,
+	"lastName":      true,
+	"fullName":      true,
+	"lastIpAddress": true,
+	"password":      true,
+	"phoneNumber":   true,
+	"picture":       true,
+	"url":           true,
+}
+
+// debugTransport logs every request and response at debug level, with credentials and personal information redacted.
+// It replaces the Debug flag of the openapi runtime, which prints everything to stderr.
+type debugTransport struct {
+	next   http.RoundTripper
+	logger log.Logger
+	// headers are the headers whose values are never logged, redactedHeaders and the header of the credentials.
+	headers []string
+}
+
+func (t *debugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request, so a copy is sent with the body restored after it is read.
+	r := new(http.Request)
+	*r = *req
+	var requestBody []byte
+	if req.Body != nil {
+		var err error
+		requestBody, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(requestBody))
+	}
+	t.logger.Debug("Organization api request", "method", r.Method, "url", r.URL.String(),
+		"header", redactHeader(r.Header, t.headers), "body", redactBody(requestBody))
+
+	start := time.Now()
+	res, err := t.next.RoundTrip(r)
+	if err != nil {
+		t.logger.Debug("Organization api request failed", "method", r.Method, "url", r.URL.String(),
+			"duration", time.Since(start), "err", err)
+		return res, err
+	}
+	responseBody, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(responseBody))
+	t.logger.Debug("Organization api response", "method", r.Method, "url", r.URL.String(), "status", res.StatusCode,
+		"duration", time.Since(start), "header", redactHeader(res.Header, t.headers), "body", redactBody(responseBody))
+	return res, nil
+}
+
+// credentialHeaders returns redactedHeaders and the header credentials send their secret in, if it is another one.
+func credentialHeaders(credentials Credentials) []string {
+	headers := append([]string(nil), redactedHeaders...)
+	if static, ok := credentials.(staticCredentials); ok {
+		if key, ok := static.authInfo.(apiKey); ok {
+			headers = append(headers, key.header)
+		}
+	}
+	return headers
+}
+
+// redactHeader returns a copy of header with the values of the headers named replaced.
+func redactHeader(header http.Header, names []string) http.Header {
+	copied := make(http.Header, len(header))
+	for k, v := range header {
+		copied[k] = v
+	}
+	for _, name := range names {
+		if copied.Get(name) != "" {
+			copied.Set(name, redacted)
+		}
+	}
+	return copied
+}
+
+// redactBody returns body with the values of redactedFields replaced.  Bodies that are not JSON are returned as is.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return string(body)
+	}
+	redactedBody, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return string(body)
+	}
+	return string(redactedBody)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, field := range v {
+			if redactedFields[k] {
+				v[k] = redacted
+			} else {
+				v[k] = redactValue(field)
+			}
+		}
+	case []interface{}:
+		for i, element := range v {
+			v[i] = redactValue(element)
+		}
+	}
+	return value
+}
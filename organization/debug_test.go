@@ -0,0 +1,218 @@
+package organization
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/3dsim/auth0/auth0fakes"
+	"github.com/3dsim/organization-goclient/models"
+	"github.com/go-openapi/strfmt"
+	log "github.com/inconshreveable/log15"
+	"github.com/stretchr/testify/assert"
+)
+
+// recordingLogger returns a logger that keeps every debug record, formatted as logfmt.
+func recordingLogger() (log.Logger, func() []string) {
+	var mu sync.Mutex
+	var records []string
+	logger := log.New()
+	logger.SetHandler(log.FuncHandler(func(r *log.Record) error {
+		mu.Lock()
+		defer mu.Unlock()
+		records = append(records, string(log.LogfmtFormat().Format(r)))
+		return nil
+	}))
+	return logger, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), records...)
+	}
+}
+
+// usersHandler serves a list with one user, or that user when it is posted.
+func usersHandler(t *testing.T) http.HandlerFunc {
+	users := []*models.User{
+		{
+			UserID:        "auth0|1",
+			Email:         "jane.doe@example.com",
+			FirstName:     "Jane",
+			LastName:      "Doe",
+			FullName:      "Jane Doe",
+			LastIPAddress: "10.1.2.3",
+		},
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var body interface{} = users
+		if r.Method == http.MethodPost {
+			body = users[0]
+		}
+		bytes, err := json.Marshal(body)
+		if err != nil {
+			t.Error("Failed to marshal user list")
+		}
+		w.Write(bytes)
+	}
+}
+
+func TestNewWithDebugExpectsRequestsLoggedWithSecretsRedacted(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("SecretToken", nil)
+	testServer := newTestServer("/organizations/{orgId}/users", usersHandler(t))
+	defer testServer.Close()
+	logger, records := recordingLogger()
+	client, err := New(
+		WithTokenFetcher(fakeTokenFetcher),
+		WithAPIGatewayURL(testServer.URL),
+		WithAPIBasePath(apiBasePath),
+		WithAudience(audience),
+		WithLogger(logger),
+		WithDebug(true),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userPost := newUserPost()
+	password := strfmt.Password("hunter2hunter2")
+	userPost.Password = &password
+	userPost.PhoneNumber = "555-1234"
+
+	// act
+	users, err := client.OrganizationUsers(1)
+	created, createErr := client.CreateOrganizationUser(1, userPost)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	assert.Nil(t, createErr, "Expected no error returned")
+	if assert.Len(t, users, 1, "Expected one user returned") {
+		assert.Equal(t, "jane.doe@example.com", users[0].Email, "Expected the response body to still be decoded")
+	}
+	if assert.NotNil(t, created, "Expected the created user returned") {
+		assert.Equal(t, "auth0|1", created.UserID, "Expected the response body to still be decoded")
+	}
+	logged := strings.Join(records(), "\n")
+	assert.Contains(t, logged, "Organization api request", "Expected the request to be logged")
+	assert.Contains(t, logged, "Organization api response", "Expected the response to be logged")
+	assert.Contains(t, logged, "auth0|1", "Expected fields that are not personal information to be logged")
+	secrets := []string{"SecretToken", "jane.doe@example.com", "Jane", "Doe", "10.1.2.3", "new.user@example.com",
+		"hunter2hunter2", "555-1234"}
+	for _, secret := range secrets {
+		assert.NotContains(t, logged, secret, "Expected secrets and personal information to be redacted")
+	}
+}
+
+func TestNewWithDebugWhenImpersonatingExpectsURLRedacted(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("SecretToken", nil)
+	testServer := newTestServer("/users/{userId}/impersonate", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"url":"https://login.example.com/impersonate?ticket=secret"}`))
+	})
+	defer testServer.Close()
+	logger, records := recordingLogger()
+	client, err := New(
+		WithTokenFetcher(fakeTokenFetcher),
+		WithAPIGatewayURL(testServer.URL),
+		WithAPIBasePath(apiBasePath),
+		WithAudience(audience),
+		WithLogger(logger),
+		WithDebug(true),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// act
+	impersonateURL, err := client.ImpersonationURL("auth0|5", ImpersonationOptions{RequestedBy: "support@example.com"})
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	if assert.NotNil(t, impersonateURL, "Expected returned impersonate url to not be nil") {
+		assert.Equal(t, "https://login.example.com/impersonate?ticket=secret", impersonateURL.URL,
+			"Expected the response body to still be decoded")
+	}
+	logged := strings.Join(records(), "\n")
+	assert.Contains(t, logged, "Organization api response", "Expected the response to be logged")
+	assert.NotContains(t, logged, "ticket=secret", "Expected the impersonation url to be redacted")
+}
+
+func TestNewWithDebugAndAPIKeyExpectsKeyHeaderRedacted(t *testing.T) {
+	// arrange
+	testServer := newTestServer("/organizations/{orgId}/users", usersHandler(t))
+	defer testServer.Close()
+	logger, records := recordingLogger()
+	client, err := New(
+		WithCredentials(APIKey("X-Tyk-Key", "SecretKey")),
+		WithAPIGatewayURL(testServer.URL),
+		WithAPIBasePath(apiBasePath),
+		WithLogger(logger),
+		WithDebug(true),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// act
+	_, err = client.OrganizationUsers(1)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	logged := strings.Join(records(), "\n")
+	assert.Contains(t, logged, "Organization api request", "Expected the request to be logged")
+	assert.NotContains(t, logged, "SecretKey", "Expected the api key header to be redacted")
+}
+
+func TestNewWithoutDebugExpectsNothingLogged(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("SecretToken", nil)
+	testServer := newTestServer("/organizations/{orgId}/users", usersHandler(t))
+	defer testServer.Close()
+	logger, records := recordingLogger()
+	client, err := New(
+		WithTokenFetcher(fakeTokenFetcher),
+		WithAPIGatewayURL(testServer.URL),
+		WithAPIBasePath(apiBasePath),
+		WithAudience(audience),
+		WithLogger(logger),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// act
+	_, err = client.OrganizationUsers(1)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	assert.Empty(t, records(), "Expected debug logging to be off by default")
+}
+
+func TestRedactBodyWhenNotJSONExpectsBodyUnchanged(t *testing.T) {
+	// act
+	body := redactBody([]byte("Bad Gateway"))
+
+	// assert
+	assert.Equal(t, "Bad Gateway", body, "Expected a body that is not JSON to be left unchanged")
+}
+
+func TestRedactHeaderExpectsOriginalHeaderUnchanged(t *testing.T) {
+	// arrange
+	header := http.Header{}
+	header.Set("Authorization", "Bearer SecretToken")
+	header.Set("Accept", "application/json")
+
+	// act
+	redactedHeader := redactHeader(header, redactedHeaders)
+
+	// assert
+	assert.Equal(t, redacted, redactedHeader.Get("Authorization"), "Expected the Authorization header to be redacted")
+	assert.Equal(t, "application/json", redactedHeader.Get("Accept"), "Expected other headers to be kept")
+	assert.Equal(t, "Bearer SecretToken", header.Get("Authorization"), "Expected the original header to be unchanged")
+}
@@ -0,0 +1,122 @@
+package organization
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/3dsim/auth0"
+)
+
+// EnvironmentVariable is the environment variable read by EnvironmentFromEnv, e.g. ORG_API_ENV=gov.
+const EnvironmentVariable = "ORG_API_ENV"
+
+// Environment bundles the API gateway URL, base path and audience of one deployment of the organization api.
+type Environment struct {
+	Name          string
+	APIGatewayURL string
+	APIBasePath   string
+	Audience      string
+}
+
+// The predefined environments.
+var (
+	QAAWS = Environment{
+		Name:          "qa-aws",
+		APIGatewayURL: "https://3dsim-qa.cloud.tyk.io",
+		APIBasePath:   "organization-api",
+		Audience:      "https://organization-qa.3dsim.com/v2",
+	}
+	QAAzure = Environment{
+		Name:          "qa-azure",
+		APIGatewayURL: "https://3dsim-qa.cloud.tyk.io",
+		APIBasePath:   "azure-organization-api",
+		Audience:      "https://organization-qa.ansys-additive.com",
+	}
+	Prod = Environment{
+		Name:          "prod",
+		APIGatewayURL: "https://3dsim.cloud.tyk.io",
+		APIBasePath:   "organization-api",
+		Audience:      "https://organization.3dsim.com/v2",
+	}
+	Gov = Environment{
+		Name:          "gov",
+		APIGatewayURL: "https://3dsim.cloud.tyk.io",
+		APIBasePath:   "organization-api",
+		Audience:      "https://organization-gov.3dsim.com",
+	}
+)
+
+// Environments lists the predefined environments.
+var Environments = []Environment{QAAWS, QAAzure, Prod, Gov}
+
+// environmentAliases maps the accepted spellings of each predefined environment to the environment.
+var environmentAliases = map[string]Environment{
+	"qa-aws":     QAAWS,
+	"qaaws":      QAAWS,
+	"qa-azure":   QAAzure,
+	"qaazure":    QAAzure,
+	"azure":      QAAzure,
+	"prod":       Prod,
+	"production": Prod,
+	"gov":        Gov,
+}
+
+// ParseEnvironment returns the predefined environment named s.  Matching ignores case and accepts "_" in place of "-".
+// The accepted names are qa-aws, qa-azure (or azure), prod (or production) and gov.  Since there are two qa
+// environments, qa alone is rejected.
+func ParseEnvironment(s string) (Environment, error) {
+	name := strings.Replace(strings.ToLower(strings.TrimSpace(s)), "_", "-", -1)
+	if environment, ok := environmentAliases[name]; ok {
+		return environment, nil
+	}
+	if name == "qa" {
+		return Environment{}, fmt.Errorf("organization: environment %q is ambiguous, use %s or %s", s, QAAWS, QAAzure)
+	}
+	return Environment{}, fmt.Errorf("organization: unknown environment %q", s)
+}
+
+// EnvironmentFromEnv returns the predefined environment named by the ORG_API_ENV environment variable.
+func EnvironmentFromEnv() (Environment, error) {
+	value, ok := os.LookupEnv(EnvironmentVariable)
+	if !ok {
+		return Environment{}, fmt.Errorf("organization: %s is not set", EnvironmentVariable)
+	}
+	return ParseEnvironment(value)
+}
+
+// String returns the name of the environment.
+func (e Environment) String() string {
+	return e.Name
+}
+
+// MarshalText implements encoding.TextMarshaler so an Environment is written to config files by name.
+func (e Environment) MarshalText() ([]byte, error) {
+	return []byte(e.Name), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler so an Environment can be read from config files by name.  See
+// ParseEnvironment for the accepted names.
+func (e *Environment) UnmarshalText(text []byte) error {
+	environment, err := ParseEnvironment(string(text))
+	if err != nil {
+		return err
+	}
+	*e = environment
+	return nil
+}
+
+// NewClientForEnvironment creates the same type of client as NewClient using the API gateway URL, base path and
+// audience of environment.
+func NewClientForEnvironment(tokenFetcher auth0.TokenFetcher, environment Environment) Client {
+	return NewClient(tokenFetcher, environment.APIGatewayURL, environment.APIBasePath, environment.Audience)
+}
+
+// WithEnvironment sets the API gateway URL, base path and audience from environment.
+func WithEnvironment(environment Environment) Option {
+	return func(cfg *config) {
+		cfg.apiGatewayURL = environment.APIGatewayURL
+		cfg.apiBasePath = environment.APIBasePath
+		cfg.audience = environment.Audience
+	}
+}
@@ -0,0 +1,150 @@
+package organization
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/3dsim/auth0/auth0fakes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseEnvironmentWhenNameKnownExpectsEnvironmentReturned(t *testing.T) {
+	testCases := []struct {
+		name     string
+		expected Environment
+	}{
+		{"qa-aws", QAAWS},
+		{"QA_AWS", QAAWS},
+		{"qa-azure", QAAzure},
+		{"Azure", QAAzure},
+		{"prod", Prod},
+		{"production", Prod},
+		{" gov ", Gov},
+		{"GOV", Gov},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// act
+			environment, err := ParseEnvironment(tc.name)
+
+			// assert
+			assert.Nil(t, err, "Expected no error returned")
+			assert.Equal(t, tc.expected, environment, "Expected environments to match")
+		})
+	}
+}
+
+func TestParseEnvironmentWhenNameUnknownExpectsErrorReturned(t *testing.T) {
+	// act
+	environment, err := ParseEnvironment("staging")
+
+	// assert
+	assert.NotNil(t, err, "Expected an error returned for an unknown environment")
+	assert.Equal(t, Environment{}, environment, "Expected an empty environment")
+}
+
+func TestParseEnvironmentWhenQAExpectsAmbiguityErrorListingBoth(t *testing.T) {
+	// act
+	environment, err := ParseEnvironment("QA")
+
+	// assert
+	if assert.NotNil(t, err, "Expected an error returned for the ambiguous qa environment") {
+		assert.Contains(t, err.Error(), QAAWS.Name, "Expected the error to name the qa aws environment")
+		assert.Contains(t, err.Error(), QAAzure.Name, "Expected the error to name the qa azure environment")
+	}
+	assert.Equal(t, Environment{}, environment, "Expected an empty environment")
+}
+
+func TestEnvironmentFromEnvWhenVariableSetExpectsEnvironmentReturned(t *testing.T) {
+	// arrange
+	previous, wasSet := os.LookupEnv(EnvironmentVariable)
+	defer func() {
+		if wasSet {
+			os.Setenv(EnvironmentVariable, previous)
+		} else {
+			os.Unsetenv(EnvironmentVariable)
+		}
+	}()
+	os.Setenv(EnvironmentVariable, "gov")
+
+	// act
+	environment, err := EnvironmentFromEnv()
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	assert.Equal(t, Gov, environment, "Expected the gov environment")
+
+	// act
+	os.Unsetenv(EnvironmentVariable)
+	_, err = EnvironmentFromEnv()
+
+	// assert
+	assert.NotNil(t, err, "Expected an error returned when the variable is not set")
+}
+
+func TestEnvironmentWhenDecodedFromConfigExpectsEnvironmentResolved(t *testing.T) {
+	// arrange
+	var config struct {
+		OrganizationAPI Environment `json:"organizationApi"`
+	}
+
+	// act
+	err := json.Unmarshal([]byte(`{"organizationApi":"qa-azure"}`), &config)
+	encoded, marshalErr := json.Marshal(config)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	assert.Equal(t, QAAzure, config.OrganizationAPI, "Expected the qa azure environment")
+	assert.Nil(t, marshalErr, "Expected no error returned")
+	assert.Equal(t, `{"organizationApi":"qa-azure"}`, string(encoded), "Expected the environment to be written by name")
+	assert.NotNil(t, json.Unmarshal([]byte(`{"organizationApi":"nowhere"}`), &config), "Expected an error for an unknown environment")
+}
+
+func TestNewWithEnvironmentExpectsEnvironmentEndpointUsed(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	testServer := newTestServer("/plans/{planID}", func(w http.ResponseWriter, r *http.Request) {
+		writePlan(w)
+	})
+	defer testServer.Close()
+	environment := Environment{
+		Name:          "test",
+		APIGatewayURL: testServer.URL,
+		APIBasePath:   apiBasePath,
+		Audience:      audience,
+	}
+
+	// act
+	client, err := New(WithTokenFetcher(fakeTokenFetcher), WithEnvironment(environment))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := client.Plan(1)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	assert.NotNil(t, plan, "Expected returned plan to not be nil")
+	assert.Equal(t, audience, fakeTokenFetcher.TokenArgsForCall(0), "Expected a token for the environment audience")
+}
+
+func TestNewClientForEnvironmentExpectsEnvironmentEndpointUsed(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	testServer := newTestServer("/plans/{planID}", func(w http.ResponseWriter, r *http.Request) {
+		writePlan(w)
+	})
+	defer testServer.Close()
+
+	// act
+	client := NewClientForEnvironment(fakeTokenFetcher, Environment{APIGatewayURL: testServer.URL, APIBasePath: apiBasePath, Audience: audience})
+	plan, err := client.Plan(1)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	assert.NotNil(t, plan, "Expected returned plan to not be nil")
+}
@@ -0,0 +1,135 @@
+package organization
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/3dsim/organization-goclient/models"
+	"github.com/go-openapi/runtime"
+)
+
+// APIError is the error returned by every Client method when the organization api responds with an error status.  It
+// saves callers from type switching over the generated error types in the "genclient/operations" package.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// OperationID is the swagger operation id of the request, e.g. "findOrganizationById".
+	OperationID string
+	// Code is the error code decoded from the models.Error body of the response, if one was sent.
+	Code int64
+	// Message is the error message decoded from the models.Error body of the response, if one was sent.
+	Message string
+	// Err is the error returned by the generated client, e.g. *operations.FindOrganizationByIDNotFound.
+	Err error
+}
+
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("%s failed with status %d: %s", e.OperationID, e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("%s failed with status %d: %v", e.OperationID, e.StatusCode, e.Err)
+}
+
+// DuplicateEmailError is returned by CreateOrganizationUser when the organization api responds with status 409 because
+// a user with the same email already exists.
+type DuplicateEmailError struct {
+	// Email is the email of the user that was not created.
+	Email string
+	// Err is the error response of the organization api.
+	Err *APIError
+}
+
+func (e *DuplicateEmailError) Error() string {
+	return "a user with the email already exists: " + e.Err.Error()
+}
+
+// IsDuplicateEmail reports whether err is a *DuplicateEmailError.
+func IsDuplicateEmail(err error) bool {
+	_, ok := err.(*DuplicateEmailError)
+	return ok
+}
+
+// StatusCode returns the HTTP status code of err if it is an *APIError or *DuplicateEmailError, otherwise 0.
+func StatusCode(err error) int {
+	switch e := err.(type) {
+	case *APIError:
+		return e.StatusCode
+	case *DuplicateEmailError:
+		return e.Err.StatusCode
+	}
+	return 0
+}
+
+// IsNotFound reports whether err is an *APIError with status 404.
+func IsNotFound(err error) bool {
+	return StatusCode(err) == http.StatusNotFound
+}
+
+// IsUnauthorized reports whether err is an *APIError with status 401.
+func IsUnauthorized(err error) bool {
+	return StatusCode(err) == http.StatusUnauthorized
+}
+
+// IsForbidden reports whether err is an *APIError with status 403.
+func IsForbidden(err error) bool {
+	return StatusCode(err) == http.StatusForbidden
+}
+
+// apiErrorTransport wraps the response reader of every operation so that error responses are returned as *APIError.
+type apiErrorTransport struct {
+	next runtime.ClientTransport
+}
+
+func (t *apiErrorTransport) Submit(operation *runtime.ClientOperation) (interface{}, error) {
+	operation.Reader = &apiErrorReader{operationID: operation.ID, next: operation.Reader}
+	return t.next.Submit(operation)
+}
+
+type apiErrorReader struct {
+	operationID string
+	next        runtime.ClientResponseReader
+}
+
+func (r *apiErrorReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	if response.Code()/100 == 2 {
+		return r.next.ReadResponse(response, consumer)
+	}
+	// The body is buffered so that it can be decoded here and still be read by the generated reader.
+	body, err := ioutil.ReadAll(response.Body())
+	if err != nil {
+		return nil, err
+	}
+	result, err := r.next.ReadResponse(&bufferedResponse{ClientResponse: response, body: body}, consumer)
+	if err == nil {
+		return result, nil
+	}
+	if _, ok := err.(*APIError); ok {
+		return nil, err
+	}
+	apiErr := &APIError{
+		StatusCode:  response.Code(),
+		OperationID: r.operationID,
+		Err:         err,
+	}
+	var payload models.Error
+	if len(body) > 0 && json.Unmarshal(body, &payload) == nil {
+		apiErr.Code = payload.Code
+		if payload.Message != nil {
+			apiErr.Message = *payload.Message
+		}
+	}
+	return nil, apiErr
+}
+
+type bufferedResponse struct {
+	runtime.ClientResponse
+	body []byte
+}
+
+func (r *bufferedResponse) Body() io.ReadCloser {
+	return ioutil.NopCloser(bytes.NewReader(r.body))
+}
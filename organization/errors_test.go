@@ -0,0 +1,130 @@
+package organization
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/3dsim/auth0/auth0fakes"
+	"github.com/3dsim/organization-goclient/genclient/operations"
+	"github.com/3dsim/organization-goclient/models"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientWhenOrganizationAPIErrorsExpectsAPIErrorForEveryOperation(t *testing.T) {
+	operationTestCases := []struct {
+		operationID string
+		call        func(Client) error
+	}{
+		{"getOrganizations", func(c Client) error { _, err := c.Organizations(); return err }},
+		{"findOrganizationById", func(c Client) error { _, err := c.Organization(1); return err }},
+		{"addOrganization", func(c Client) error { _, err := c.CreateOrganization(newValidOrganization()); return err }},
+		{"putOrganization", func(c Client) error { _, err := c.UpdateOrganization(newValidOrganization()); return err }},
+		{"getSubscriptions", func(c Client) error { _, err := c.Subscriptions(nil); return err }},
+		{"getSubscriptionsByOrganization", func(c Client) error { _, err := c.OrganizationSubscriptions(1); return err }},
+		{"getSubscription", func(c Client) error { _, err := c.Subscription(1, 2); return err }},
+		{"putSubscription", func(c Client) error {
+			_, err := c.UpdateSubscription(&models.Subscription{ID: 1, OrganizationID: 1})
+			return err
+		}},
+		{"addSubscription", func(c Client) error {
+			_, err := c.CreateSubscription(1, 1, models.SubscriptionPaymentMethodCreditCard)
+			return err
+		}},
+		{"getPlan", func(c Client) error { _, err := c.Plan(1); return err }},
+		{"getPlans", func(c Client) error { _, err := c.Plans(ListPlansOptions{}); return err }},
+		{"getUsersByOrganization", func(c Client) error { _, err := c.OrganizationUsers(1); return err }},
+		{"addUserToOrganization", func(c Client) error { _, err := c.CreateOrganizationUser(1, newUserPost()); return err }},
+		{"impersonateUser", func(c Client) error {
+			_, err := c.ImpersonationURL("auth0|1", ImpersonationOptions{RequestedBy: "support@example.com"})
+			return err
+		}},
+	}
+	statusTestCases := []struct {
+		statusCode int
+		check      func(error) bool
+	}{
+		{http.StatusUnauthorized, IsUnauthorized},
+		{http.StatusForbidden, IsForbidden},
+		{http.StatusNotFound, IsNotFound},
+		{http.StatusInternalServerError, func(err error) bool { return StatusCode(err) == http.StatusInternalServerError }},
+	}
+
+	for _, otc := range operationTestCases {
+		for _, stc := range statusTestCases {
+			t.Run(otc.operationID+"/"+http.StatusText(stc.statusCode), func(t *testing.T) {
+				// arrange
+				fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+				fakeTokenFetcher.TokenReturns("Token", nil)
+
+				handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
+					w.WriteHeader(stc.statusCode)
+					w.Write([]byte(`{"code":42,"message":"something went wrong"}`))
+				})
+
+				// Setup routes
+				r := mux.NewRouter()
+				r.PathPrefix("/" + apiBasePath).Handler(handler)
+				testServer := httptest.NewServer(r)
+				defer testServer.Close()
+				client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+
+				// act
+				err := otc.call(client)
+
+				// assert
+				assert.True(t, stc.check(err), "Expected the status to be recognized")
+				apiErr, ok := err.(*APIError)
+				if !assert.True(t, ok, "Expected an *APIError returned") {
+					return
+				}
+				assert.Equal(t, stc.statusCode, apiErr.StatusCode, "Expected status codes to match")
+				assert.Equal(t, otc.operationID, apiErr.OperationID, "Expected operation ids to match")
+				assert.Equal(t, int64(42), apiErr.Code, "Expected error code to be decoded")
+				assert.Equal(t, "something went wrong", apiErr.Message, "Expected error message to be decoded")
+				assert.NotNil(t, apiErr.Err, "Expected the generated error to be kept")
+			})
+		}
+	}
+}
+
+func TestOrganizationWhenNotFoundWithoutBodyExpectsAPIErrorWrappingGeneratedError(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	// Setup routes
+	r := mux.NewRouter()
+	r.HandleFunc("/"+apiBasePath+"/organizations/{organizationID}", handler)
+	testServer := httptest.NewServer(r)
+	defer testServer.Close()
+	client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+
+	// act
+	_, err := client.Organization(1)
+
+	// assert
+	assert.True(t, IsNotFound(err), "Expected a not found error")
+	apiErr := err.(*APIError)
+	assert.Empty(t, apiErr.Message, "Expected no message because no body was sent")
+	_, ok := apiErr.Err.(*operations.FindOrganizationByIDNotFound)
+	assert.True(t, ok, "Expected the generated not found error to be kept")
+	assert.Contains(t, err.Error(), "findOrganizationById", "Expected the operation id in the error message")
+}
+
+func TestStatusHelpersWhenErrorIsNotAPIErrorExpectsFalse(t *testing.T) {
+	err := errors.New("Some auth0 error")
+
+	assert.Equal(t, 0, StatusCode(err), "Expected no status code")
+	assert.False(t, IsNotFound(err), "Expected not to be a not found error")
+	assert.False(t, IsUnauthorized(err), "Expected not to be an unauthorized error")
+	assert.False(t, IsForbidden(err), "Expected not to be a forbidden error")
+	assert.False(t, IsNotFound(nil), "Expected nil not to be a not found error")
+}
@@ -0,0 +1,218 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/3dsim/organization-goclient/models"
+)
+
+// DefaultPageSize is the number of items requested per page by the iterators when no page size is given.
+const DefaultPageSize int32 = 100
+
+// pager holds the paging state shared by the iterators.  It walks Limit/Offset pages until a short page is returned.
+type pager struct {
+	ctx      context.Context
+	pageSize int32
+	offset   int32
+	done     bool
+	err      error
+}
+
+func newPager(ctx context.Context, pageSize int32, offset *int32) pager {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	p := pager{ctx: ctx, pageSize: pageSize}
+	if offset != nil {
+		p.offset = *offset
+	}
+	return p
+}
+
+// more reports whether another page should be fetched.  A done context stops the iteration and is recorded as the error.
+func (p *pager) more() bool {
+	if p.done || p.err != nil {
+		return false
+	}
+	if err := p.ctx.Err(); err != nil {
+		p.err = err
+		return false
+	}
+	return true
+}
+
+// advance records the result of fetching a page of n items.
+func (p *pager) advance(n int, err error) {
+	if err != nil {
+		p.err = err
+		return
+	}
+	p.offset += int32(n)
+	if int32(n) < p.pageSize {
+		p.done = true
+	}
+}
+
+// OrganizationIterator walks every organization matching a set of ListOrganizationsOptions, fetching one page at a
+// time.  Use it like this:
+//
+// 		it := organization.NewOrganizationIterator(ctx, client, organization.ListOrganizationsOptions{}, 0)
+// 		for it.Next() {
+// 			org := it.Value()
+// 		}
+// 		if err := it.Err(); err != nil {
+// 			// handle error
+// 		}
+type OrganizationIterator struct {
+	client  Client
+	options ListOrganizationsOptions
+	pager   pager
+	page    []*models.Organization
+	index   int
+	value   *models.Organization
+}
+
+// NewOrganizationIterator creates an iterator over the organizations matching options.  Pages of pageSize
+// organizations are requested starting at options.Offset; options.Limit is ignored.  A pageSize <= 0 uses
+// DefaultPageSize.  Iteration stops with ctx.Err() once ctx is done.
+func NewOrganizationIterator(ctx context.Context, client Client, options ListOrganizationsOptions, pageSize int32) *OrganizationIterator {
+	return &OrganizationIterator{
+		client:  client,
+		options: options,
+		pager:   newPager(ctx, pageSize, options.Offset),
+	}
+}
+
+// Next advances the iterator to the next organization, fetching the next page when needed.  It returns false when
+// there are no more organizations or an error occurred.
+func (it *OrganizationIterator) Next() bool {
+	for it.index >= len(it.page) {
+		if !it.pager.more() {
+			it.value = nil
+			return false
+		}
+		options := it.options
+		limit, offset := it.pager.pageSize, it.pager.offset
+		options.Limit, options.Offset = &limit, &offset
+		page, err := it.client.OrganizationsWithOptionsCtx(it.pager.ctx, options)
+		it.pager.advance(len(page), err)
+		it.page, it.index = page, 0
+	}
+	it.value = it.page[it.index]
+	it.index++
+	return true
+}
+
+// Value returns the organization the iterator is positioned at.
+func (it *OrganizationIterator) Value() *models.Organization {
+	return it.value
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (it *OrganizationIterator) Err() error {
+	return it.pager.err
+}
+
+// SubscriptionIterator walks every subscription matching a set of ListSubscriptionsOptions, fetching one page at a
+// time.  It is used the same way as OrganizationIterator.
+type SubscriptionIterator struct {
+	client  Client
+	options ListSubscriptionsOptions
+	pager   pager
+	page    []*models.Subscription
+	index   int
+	value   *models.Subscription
+}
+
+// NewSubscriptionIterator creates an iterator over the subscriptions matching options.  Pages of pageSize
+// subscriptions are requested starting at options.Offset; options.Limit is ignored.  A pageSize <= 0 uses
+// DefaultPageSize.  Iteration stops with ctx.Err() once ctx is done.
+func NewSubscriptionIterator(ctx context.Context, client Client, options ListSubscriptionsOptions, pageSize int32) *SubscriptionIterator {
+	return &SubscriptionIterator{
+		client:  client,
+		options: options,
+		pager:   newPager(ctx, pageSize, options.Offset),
+	}
+}
+
+// Next advances the iterator to the next subscription, fetching the next page when needed.  It returns false when
+// there are no more subscriptions or an error occurred.
+func (it *SubscriptionIterator) Next() bool {
+	for it.index >= len(it.page) {
+		if !it.pager.more() {
+			it.value = nil
+			return false
+		}
+		options := it.options
+		limit, offset := it.pager.pageSize, it.pager.offset
+		options.Limit, options.Offset = &limit, &offset
+		page, err := it.client.SubscriptionsWithOptionsCtx(it.pager.ctx, options)
+		it.pager.advance(len(page), err)
+		it.page, it.index = page, 0
+	}
+	it.value = it.page[it.index]
+	it.index++
+	return true
+}
+
+// Value returns the subscription the iterator is positioned at.
+func (it *SubscriptionIterator) Value() *models.Subscription {
+	return it.value
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (it *SubscriptionIterator) Err() error {
+	return it.pager.err
+}
+
+// PlanIterator walks every plan matching a set of ListPlansOptions, fetching one page at a time.  It is used the same
+// way as OrganizationIterator.
+type PlanIterator struct {
+	client  Client
+	options ListPlansOptions
+	pager   pager
+	page    []*models.Plan
+	index   int
+	value   *models.Plan
+}
+
+// NewPlanIterator creates an iterator over the plans matching options.  Pages of pageSize plans are requested starting
+// at options.Offset; options.Limit is ignored.  A pageSize <= 0 uses DefaultPageSize.  Iteration stops with ctx.Err()
+// once ctx is done.
+func NewPlanIterator(ctx context.Context, client Client, options ListPlansOptions, pageSize int32) *PlanIterator {
+	return &PlanIterator{
+		client:  client,
+		options: options,
+		pager:   newPager(ctx, pageSize, options.Offset),
+	}
+}
+
+// Next advances the iterator to the next plan, fetching the next page when needed.  It returns false when there are no
+// more plans or an error occurred.
+func (it *PlanIterator) Next() bool {
+	for it.index >= len(it.page) {
+		if !it.pager.more() {
+			it.value = nil
+			return false
+		}
+		options := it.options
+		limit, offset := it.pager.pageSize, it.pager.offset
+		options.Limit, options.Offset = &limit, &offset
+		page, err := it.client.PlansCtx(it.pager.ctx, options)
+		it.pager.advance(len(page), err)
+		it.page, it.index = page, 0
+	}
+	it.value = it.page[it.index]
+	it.index++
+	return true
+}
+
+// Value returns the plan the iterator is positioned at.
+func (it *PlanIterator) Value() *models.Plan {
+	return it.value
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (it *PlanIterator) Err() error {
+	return it.pager.err
+}
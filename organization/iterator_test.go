@@ -0,0 +1,221 @@
+package organization_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/3dsim/organization-goclient/models"
+	"github.com/3dsim/organization-goclient/organization"
+	"github.com/3dsim/organization-goclient/organization/organizationfakes"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+)
+
+func organizationPage(ids ...int32) []*models.Organization {
+	page := []*models.Organization{}
+	for _, id := range ids {
+		page = append(page, &models.Organization{ID: id})
+	}
+	return page
+}
+
+func subscriptionPage(ids ...int32) []*models.Subscription {
+	page := []*models.Subscription{}
+	for _, id := range ids {
+		page = append(page, &models.Subscription{ID: id})
+	}
+	return page
+}
+
+func planPage(ids ...int32) []*models.Plan {
+	page := []*models.Plan{}
+	for _, id := range ids {
+		page = append(page, &models.Plan{ID: id})
+	}
+	return page
+}
+
+func TestOrganizationIteratorWhenLastPageIsShortExpectsAllOrganizationsVisited(t *testing.T) {
+	// arrange
+	fakeClient := &organizationfakes.FakeClient{}
+	fakeClient.OrganizationsWithOptionsCtxReturnsOnCall(0, organizationPage(1, 2), nil)
+	fakeClient.OrganizationsWithOptionsCtxReturnsOnCall(1, organizationPage(3, 4), nil)
+	fakeClient.OrganizationsWithOptionsCtxReturnsOnCall(2, organizationPage(5), nil)
+	options := organization.ListOrganizationsOptions{Active: swag.Bool(true)}
+
+	// act
+	it := organization.NewOrganizationIterator(context.Background(), fakeClient, options, 2)
+	var ids []int32
+	for it.Next() {
+		ids = append(ids, it.Value().ID)
+	}
+
+	// assert
+	assert.Nil(t, it.Err(), "Expected no error")
+	assert.Equal(t, []int32{1, 2, 3, 4, 5}, ids, "Expected every organization to be visited in order")
+	assert.Equal(t, 3, fakeClient.OrganizationsWithOptionsCtxCallCount(), "Expected one call per page")
+	for i, expectedOffset := range []int32{0, 2, 4} {
+		_, received := fakeClient.OrganizationsWithOptionsCtxArgsForCall(i)
+		assert.Equal(t, int32(2), *received.Limit, "Expected limit to be the page size")
+		assert.Equal(t, expectedOffset, *received.Offset, "Expected offset to advance by the page size")
+		assert.Equal(t, true, *received.Active, "Expected filters to be passed through")
+	}
+	assert.Nil(t, it.Value(), "Expected no value after the iteration is done")
+}
+
+func TestOrganizationIteratorWhenLastPageIsEmptyExpectsIterationToStop(t *testing.T) {
+	// arrange
+	fakeClient := &organizationfakes.FakeClient{}
+	fakeClient.OrganizationsWithOptionsCtxReturnsOnCall(0, organizationPage(1, 2), nil)
+	fakeClient.OrganizationsWithOptionsCtxReturnsOnCall(1, organizationPage(), nil)
+
+	// act
+	it := organization.NewOrganizationIterator(context.Background(), fakeClient, organization.ListOrganizationsOptions{}, 2)
+	count := 0
+	for it.Next() {
+		count++
+	}
+
+	// assert
+	assert.Nil(t, it.Err(), "Expected no error")
+	assert.Equal(t, 2, count, "Expected both organizations to be visited")
+	assert.Equal(t, 2, fakeClient.OrganizationsWithOptionsCtxCallCount(), "Expected iteration to stop at the empty page")
+}
+
+func TestOrganizationIteratorWhenStartOffsetGivenExpectsPagingFromOffset(t *testing.T) {
+	// arrange
+	fakeClient := &organizationfakes.FakeClient{}
+	fakeClient.OrganizationsWithOptionsCtxReturns(organizationPage(), nil)
+	options := organization.ListOrganizationsOptions{Offset: swag.Int32(40), Limit: swag.Int32(1)}
+
+	// act
+	it := organization.NewOrganizationIterator(context.Background(), fakeClient, options, 0)
+	for it.Next() {
+	}
+
+	// assert
+	_, received := fakeClient.OrganizationsWithOptionsCtxArgsForCall(0)
+	assert.Equal(t, organization.DefaultPageSize, *received.Limit, "Expected the default page size to be used")
+	assert.Equal(t, int32(40), *received.Offset, "Expected paging to start at the given offset")
+}
+
+func TestOrganizationIteratorWhenClientErrorsExpectsErrorReturned(t *testing.T) {
+	// arrange
+	expectedError := errors.New("Some organization api error")
+	fakeClient := &organizationfakes.FakeClient{}
+	fakeClient.OrganizationsWithOptionsCtxReturnsOnCall(0, organizationPage(1, 2), nil)
+	fakeClient.OrganizationsWithOptionsCtxReturnsOnCall(1, nil, expectedError)
+
+	// act
+	it := organization.NewOrganizationIterator(context.Background(), fakeClient, organization.ListOrganizationsOptions{}, 2)
+	count := 0
+	for it.Next() {
+		count++
+	}
+
+	// assert
+	assert.Equal(t, expectedError, it.Err(), "Expected the client error returned")
+	assert.Equal(t, 2, count, "Expected the first page to be visited")
+	assert.False(t, it.Next(), "Expected iteration to stay stopped after an error")
+	assert.Equal(t, 2, fakeClient.OrganizationsWithOptionsCtxCallCount(), "Expected no more calls after an error")
+}
+
+func TestOrganizationIteratorWhenContextCanceledExpectsContextErrorReturned(t *testing.T) {
+	// arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	fakeClient := &organizationfakes.FakeClient{}
+	fakeClient.OrganizationsWithOptionsCtxStub = func(context.Context, organization.ListOrganizationsOptions) ([]*models.Organization, error) {
+		cancel()
+		return organizationPage(1, 2), nil
+	}
+
+	// act
+	it := organization.NewOrganizationIterator(ctx, fakeClient, organization.ListOrganizationsOptions{}, 2)
+	count := 0
+	for it.Next() {
+		count++
+	}
+
+	// assert
+	assert.Equal(t, context.Canceled, it.Err(), "Expected the context error returned")
+	assert.Equal(t, 2, count, "Expected the page fetched before cancellation to be visited")
+	assert.Equal(t, 1, fakeClient.OrganizationsWithOptionsCtxCallCount(), "Expected no calls after cancellation")
+}
+
+func TestSubscriptionIteratorWhenLastPageIsShortExpectsAllSubscriptionsVisited(t *testing.T) {
+	// arrange
+	fakeClient := &organizationfakes.FakeClient{}
+	fakeClient.SubscriptionsWithOptionsCtxReturnsOnCall(0, subscriptionPage(1, 2, 3), nil)
+	fakeClient.SubscriptionsWithOptionsCtxReturnsOnCall(1, subscriptionPage(4), nil)
+	options := organization.ListSubscriptionsOptions{
+		Active:        swag.Bool(true),
+		PaymentMethod: swag.String(models.SubscriptionPaymentMethodPurchaseOrder),
+	}
+
+	// act
+	it := organization.NewSubscriptionIterator(context.Background(), fakeClient, options, 3)
+	var ids []int32
+	for it.Next() {
+		ids = append(ids, it.Value().ID)
+	}
+
+	// assert
+	assert.Nil(t, it.Err(), "Expected no error")
+	assert.Equal(t, []int32{1, 2, 3, 4}, ids, "Expected every subscription to be visited in order")
+	assert.Equal(t, 2, fakeClient.SubscriptionsWithOptionsCtxCallCount(), "Expected one call per page")
+	_, received := fakeClient.SubscriptionsWithOptionsCtxArgsForCall(1)
+	assert.Equal(t, int32(3), *received.Offset, "Expected offset to advance by the page size")
+	assert.Equal(t, models.SubscriptionPaymentMethodPurchaseOrder, *received.PaymentMethod, "Expected filters to be passed through")
+}
+
+func TestSubscriptionIteratorWhenClientErrorsExpectsErrorReturned(t *testing.T) {
+	// arrange
+	expectedError := errors.New("Some organization api error")
+	fakeClient := &organizationfakes.FakeClient{}
+	fakeClient.SubscriptionsWithOptionsCtxReturns(nil, expectedError)
+
+	// act
+	it := organization.NewSubscriptionIterator(context.Background(), fakeClient, organization.ListSubscriptionsOptions{}, 10)
+
+	// assert
+	assert.False(t, it.Next(), "Expected no subscriptions")
+	assert.Equal(t, expectedError, it.Err(), "Expected the client error returned")
+}
+
+func TestPlanIteratorWhenLastPageIsShortExpectsAllPlansVisited(t *testing.T) {
+	// arrange
+	fakeClient := &organizationfakes.FakeClient{}
+	fakeClient.PlansCtxReturnsOnCall(0, planPage(1, 2), nil)
+	fakeClient.PlansCtxReturnsOnCall(1, planPage(3), nil)
+	options := organization.ListPlansOptions{AllowWebSignup: swag.Bool(true), PlanGroup: swag.String("Additive")}
+
+	// act
+	it := organization.NewPlanIterator(context.Background(), fakeClient, options, 2)
+	var ids []int32
+	for it.Next() {
+		ids = append(ids, it.Value().ID)
+	}
+
+	// assert
+	assert.Nil(t, it.Err(), "Expected no error")
+	assert.Equal(t, []int32{1, 2, 3}, ids, "Expected every plan to be visited in order")
+	assert.Equal(t, 2, fakeClient.PlansCtxCallCount(), "Expected one call per page")
+	_, received := fakeClient.PlansCtxArgsForCall(1)
+	assert.Equal(t, int32(2), *received.Offset, "Expected offset to advance by the page size")
+	assert.Equal(t, "Additive", *received.PlanGroup, "Expected filters to be passed through")
+}
+
+func TestPlanIteratorWhenClientErrorsExpectsErrorReturned(t *testing.T) {
+	// arrange
+	expectedError := errors.New("Some organization api error")
+	fakeClient := &organizationfakes.FakeClient{}
+	fakeClient.PlansCtxReturns(nil, expectedError)
+
+	// act
+	it := organization.NewPlanIterator(context.Background(), fakeClient, organization.ListPlansOptions{}, 10)
+
+	// assert
+	assert.False(t, it.Next(), "Expected no plans")
+	assert.Equal(t, expectedError, it.Err(), "Expected the client error returned")
+}
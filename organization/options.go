@@ -0,0 +1,198 @@
+package organization
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/3dsim/auth0"
+	openapiclient "github.com/go-openapi/runtime/client"
+	log "github.com/inconshreveable/log15"
+)
+
+// Option configures a client created by New.
+type Option func(*config)
+
+type config struct {
+	tokenFetcher    auth0.TokenFetcher
+	apiGatewayURL   string
+	apiBasePath     string
+	audience        string
+	httpClient      *http.Client
+	roundTripper    http.RoundTripper
+	timeout         time.Duration
+	retryPolicy     *RetryPolicy
+	logger          log.Logger
+	debug           bool
+	userAgent       string
+	tokenExpirySkew time.Duration
+	credentials     Credentials
+	interceptors    []Interceptor
+}
+
+// New creates a new client for interacting with the 3DSIM organization api.  A token fetcher, API gateway URL and
+// audience are required, unless WithCredentials is used instead of a token fetcher and audience.  Use WithEnvironment
+// to set the endpoint of a predefined environment.  Unlike NewClient, New returns an error for an invalid configuration
+// instead of panicking.
+//
+// 		client, err := organization.New(
+// 			organization.WithTokenFetcher(tokenFetcher),
+// 			organization.WithEnvironment(organization.Prod),
+// 			organization.WithTimeout(10*time.Second),
+// 		)
+func New(opts ...Option) (Client, error) {
+	cfg, err := newConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+	return newClient(cfg)
+}
+
+// newConfig applies opts to the defaults and validates the result.
+func newConfig(opts []Option) (config, error) {
+	cfg := config{
+		timeout:         openapiclient.DefaultTimeout,
+		logger:          Log,
+		tokenExpirySkew: DefaultTokenExpirySkew,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if err := cfg.validate(); err != nil {
+		cfg.logger.Error("Invalid organization client configuration", "err", err)
+		return config{}, err
+	}
+	return cfg, nil
+}
+
+func (cfg config) validate() error {
+	if cfg.credentials == nil {
+		if cfg.tokenFetcher == nil {
+			return errors.New("organization: a token fetcher or credentials are required")
+		}
+		if cfg.audience == "" {
+			return errors.New("organization: an audience is required")
+		}
+	}
+	parsedURL, err := url.Parse(cfg.apiGatewayURL)
+	if err != nil {
+		return fmt.Errorf("organization: API gateway URL %q is invalid: %v", cfg.apiGatewayURL, err)
+	}
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		return fmt.Errorf("organization: API gateway URL %q must use http or https", cfg.apiGatewayURL)
+	}
+	if parsedURL.Host == "" {
+		return fmt.Errorf("organization: API gateway URL %q has no host", cfg.apiGatewayURL)
+	}
+	if cfg.timeout < 0 {
+		return fmt.Errorf("organization: timeout must not be negative, got %v", cfg.timeout)
+	}
+	if cfg.retryPolicy != nil && (cfg.retryPolicy.Retry == nil || cfg.retryPolicy.Delay == nil) {
+		return errors.New("organization: a retry policy requires both a Retry and a Delay function")
+	}
+	return nil
+}
+
+// WithTokenFetcher sets the token fetcher used to authenticate requests.  See the auth0 package for how to construct
+// one.
+func WithTokenFetcher(tokenFetcher auth0.TokenFetcher) Option {
+	return func(cfg *config) {
+		cfg.tokenFetcher = tokenFetcher
+	}
+}
+
+// WithAPIGatewayURL sets the URL of the API gateway, e.g. https://3dsim.cloud.tyk.io.
+func WithAPIGatewayURL(apiGatewayURL string) Option {
+	return func(cfg *config) {
+		cfg.apiGatewayURL = apiGatewayURL
+	}
+}
+
+// WithAPIBasePath sets the base path of the organization api on the API gateway, e.g. organization-api.
+func WithAPIBasePath(apiBasePath string) Option {
+	return func(cfg *config) {
+		cfg.apiBasePath = apiBasePath
+	}
+}
+
+// WithAudience sets the audience tokens are requested for, e.g. https://organization.3dsim.com/v2.
+func WithAudience(audience string) Option {
+	return func(cfg *config) {
+		cfg.audience = audience
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to send requests.  Round trippers added by other options wrap the
+// client's transport.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(cfg *config) {
+		cfg.httpClient = httpClient
+	}
+}
+
+// WithRoundTripper sets the transport used to send requests.  It replaces the transport of the client given to
+// WithHTTPClient.
+func WithRoundTripper(roundTripper http.RoundTripper) Option {
+	return func(cfg *config) {
+		cfg.roundTripper = roundTripper
+	}
+}
+
+// WithTimeout sets the request timeout of the client, including any retries.  The default is
+// openapiclient.DefaultTimeout.  A timeout of 0 means no timeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(cfg *config) {
+		cfg.timeout = timeout
+	}
+}
+
+// WithRetryPolicy retries failed requests according to policy until the request timeout elapses.  Both Retry and Delay
+// of policy are required.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(cfg *config) {
+		cfg.retryPolicy = &policy
+	}
+}
+
+// WithLogger sets the logger the client logs to instead of the package Log.
+func WithLogger(logger log.Logger) Option {
+	return func(cfg *config) {
+		cfg.logger = logger
+	}
+}
+
+// WithDebug turns logging of every request and response on or off.  It is off by default.  Requests and responses are
+// logged at debug level to the client's logger, see WithLogger, with the Authorization header and personal information
+// such as the email and name of users redacted.
+func WithDebug(debug bool) Option {
+	return func(cfg *config) {
+		cfg.debug = debug
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(cfg *config) {
+		cfg.userAgent = userAgent
+	}
+}
+
+// userAgentTransport sets the User-Agent header on every request.
+type userAgentTransport struct {
+	next      http.RoundTripper
+	userAgent string
+}
+
+func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request, so the header is set on a copy.
+	r := new(http.Request)
+	*r = *req
+	r.Header = make(http.Header, len(req.Header)+1)
+	for k, v := range req.Header {
+		r.Header[k] = v
+	}
+	r.Header.Set("User-Agent", t.userAgent)
+	return t.next.RoundTrip(r)
+}
@@ -0,0 +1,238 @@
+package organization
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/3dsim/auth0/auth0fakes"
+	"github.com/PuerkitoBio/rehttp"
+	"github.com/stretchr/testify/assert"
+)
+
+type countingRoundTripper struct {
+	calls int32
+	next  http.RoundTripper
+}
+
+func (rt *countingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	atomic.AddInt32(&rt.calls, 1)
+	return rt.next.RoundTrip(req)
+}
+
+func writePlan(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(`{"id":1,"name":"Plan name"}`))
+}
+
+func TestNewWhenConfigurationInvalidExpectsErrorReturned(t *testing.T) {
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	testCases := []struct {
+		name string
+		opts []Option
+	}{
+		{"no token fetcher", []Option{WithAPIGatewayURL("https://3dsim.cloud.tyk.io"), WithAudience(audience)}},
+		{"no audience", []Option{WithTokenFetcher(fakeTokenFetcher), WithAPIGatewayURL("https://3dsim.cloud.tyk.io")}},
+		{"no gateway url", []Option{WithTokenFetcher(fakeTokenFetcher), WithAudience(audience)}},
+		{"gateway url without scheme", []Option{WithTokenFetcher(fakeTokenFetcher), WithAPIGatewayURL("3dsim.cloud.tyk.io"), WithAudience(audience)}},
+		{"unparsable gateway url", []Option{WithTokenFetcher(fakeTokenFetcher), WithAPIGatewayURL("https://3dsim cloud%"), WithAudience(audience)}},
+		{"negative timeout", []Option{WithTokenFetcher(fakeTokenFetcher), WithAPIGatewayURL("https://3dsim.cloud.tyk.io"), WithAudience(audience), WithTimeout(-time.Second)}},
+		{"retry policy without delay", []Option{WithTokenFetcher(fakeTokenFetcher), WithAPIGatewayURL("https://3dsim.cloud.tyk.io"), WithAudience(audience), WithRetryPolicy(RetryPolicy{Retry: rehttp.RetryMaxRetries(1)})}},
+		{"retry policy without retry", []Option{WithTokenFetcher(fakeTokenFetcher), WithAPIGatewayURL("https://3dsim.cloud.tyk.io"), WithAudience(audience), WithRetryPolicy(RetryPolicy{Delay: rehttp.ConstDelay(time.Millisecond)})}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// act
+			client, err := New(tc.opts...)
+
+			// assert
+			assert.NotNil(t, err, "Expected an error returned for an invalid configuration")
+			assert.Nil(t, client, "Expected client to be nil")
+		})
+	}
+}
+
+func TestNewWhenSuccessfulExpectsPlanReturned(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	var receivedUserAgent string
+	testServer := newTestServer("/plans/{planID}", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer Token", r.Header.Get("Authorization"), "Expected the token to be sent")
+		receivedUserAgent = r.Header.Get("User-Agent")
+		writePlan(w)
+	})
+	defer testServer.Close()
+
+	// act
+	client, err := New(
+		WithTokenFetcher(fakeTokenFetcher),
+		WithAPIGatewayURL(testServer.URL),
+		WithAPIBasePath(apiBasePath),
+		WithAudience(audience),
+		WithUserAgent("organization-goclient-test"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := client.Plan(1)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	assert.Equal(t, int32(1), plan.ID, "Expected IDs to match")
+	assert.Equal(t, "organization-goclient-test", receivedUserAgent, "Expected the user agent to be sent")
+	assert.Equal(t, audience, fakeTokenFetcher.TokenArgsForCall(0), "Expected a token for the audience")
+}
+
+func TestNewWithRoundTripperExpectsRoundTripperUsed(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	testServer := newTestServer("/plans/{planID}", func(w http.ResponseWriter, r *http.Request) {
+		writePlan(w)
+	})
+	defer testServer.Close()
+	roundTripper := &countingRoundTripper{next: http.DefaultTransport}
+
+	// act
+	client, err := New(
+		WithTokenFetcher(fakeTokenFetcher),
+		WithAPIGatewayURL(testServer.URL),
+		WithAPIBasePath(apiBasePath),
+		WithAudience(audience),
+		WithRoundTripper(roundTripper),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.Plan(1)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&roundTripper.calls), "Expected the round tripper to send the request")
+}
+
+func TestNewWithHTTPClientExpectsHTTPClientTransportUsed(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	testServer := newTestServer("/plans/{planID}", func(w http.ResponseWriter, r *http.Request) {
+		writePlan(w)
+	})
+	defer testServer.Close()
+	roundTripper := &countingRoundTripper{next: http.DefaultTransport}
+	httpClient := &http.Client{Transport: roundTripper}
+
+	// act
+	client, err := New(
+		WithTokenFetcher(fakeTokenFetcher),
+		WithAPIGatewayURL(testServer.URL),
+		WithAPIBasePath(apiBasePath),
+		WithAudience(audience),
+		WithHTTPClient(httpClient),
+		WithUserAgent("organization-goclient-test"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.Plan(1)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&roundTripper.calls), "Expected the http client's transport to send the request")
+	assert.Equal(t, roundTripper, httpClient.Transport, "Expected the given http client to be left unchanged")
+}
+
+func TestNewWithRetryPolicyExpectsRetryUntilPolicyStops(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	var callCounter int32
+	testServer := newTestServer("/plans/{planID}", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&callCounter, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		writePlan(w)
+	})
+	defer testServer.Close()
+
+	// act
+	client, err := New(
+		WithTokenFetcher(fakeTokenFetcher),
+		WithAPIGatewayURL(testServer.URL),
+		WithAPIBasePath(apiBasePath),
+		WithAudience(audience),
+		WithRetryPolicy(RetryPolicy{
+			Retry: rehttp.RetryAll(rehttp.RetryMaxRetries(5), rehttp.RetryStatuses(http.StatusServiceUnavailable)),
+			Delay: rehttp.ConstDelay(time.Millisecond),
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := client.Plan(1)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned because the third attempt succeeded")
+	assert.NotNil(t, plan, "Expected returned plan to not be nil")
+	assert.Equal(t, int32(3), atomic.LoadInt32(&callCounter), "Expected two retries")
+}
+
+func TestNewWithTimeoutExpectsTimeoutApplied(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	testServer := newTestServer("/plans/{planID}", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		writePlan(w)
+	})
+	defer testServer.Close()
+
+	// act
+	client, err := New(
+		WithTokenFetcher(fakeTokenFetcher),
+		WithAPIGatewayURL(testServer.URL),
+		WithAPIBasePath(apiBasePath),
+		WithAudience(audience),
+		WithTimeout(50*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := client.Plan(1)
+
+	// assert
+	assert.NotNil(t, err, "Expected an error returned because the timeout elapsed")
+	assert.Nil(t, plan, "Expected plan to be nil")
+}
+
+func TestNewWithTimeoutZeroExpectsNoTimeout(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	testServer := newTestServer("/plans/{planID}", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		writePlan(w)
+	})
+	defer testServer.Close()
+
+	// act
+	client, err := New(
+		WithTokenFetcher(fakeTokenFetcher),
+		WithAPIGatewayURL(testServer.URL),
+		WithAPIBasePath(apiBasePath),
+		WithAudience(audience),
+		WithTimeout(0),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := client.Plan(1)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned because there is no timeout")
+	assert.NotNil(t, plan, "Expected returned plan to not be nil")
+}
@@ -2,6 +2,7 @@
 package organizationfakes
 
 import (
+	"context"
 	"sync"
 
 	"github.com/3dsim/organization-goclient/models"
@@ -20,6 +21,46 @@ type FakeClient struct {
 		result1 []*models.Organization
 		result2 error
 	}
+	OrganizationsCtxStub        func(ctx context.Context) ([]*models.Organization, error)
+	organizationsCtxMutex       sync.RWMutex
+	organizationsCtxArgsForCall []struct {
+		ctx context.Context
+	}
+	organizationsCtxReturns struct {
+		result1 []*models.Organization
+		result2 error
+	}
+	organizationsCtxReturnsOnCall map[int]struct {
+		result1 []*models.Organization
+		result2 error
+	}
+	OrganizationsWithOptionsStub        func(options organization.ListOrganizationsOptions) ([]*models.Organization, error)
+	organizationsWithOptionsMutex       sync.RWMutex
+	organizationsWithOptionsArgsForCall []struct {
+		options organization.ListOrganizationsOptions
+	}
+	organizationsWithOptionsReturns struct {
+		result1 []*models.Organization
+		result2 error
+	}
+	organizationsWithOptionsReturnsOnCall map[int]struct {
+		result1 []*models.Organization
+		result2 error
+	}
+	OrganizationsWithOptionsCtxStub        func(ctx context.Context, options organization.ListOrganizationsOptions) ([]*models.Organization, error)
+	organizationsWithOptionsCtxMutex       sync.RWMutex
+	organizationsWithOptionsCtxArgsForCall []struct {
+		ctx     context.Context
+		options organization.ListOrganizationsOptions
+	}
+	organizationsWithOptionsCtxReturns struct {
+		result1 []*models.Organization
+		result2 error
+	}
+	organizationsWithOptionsCtxReturnsOnCall map[int]struct {
+		result1 []*models.Organization
+		result2 error
+	}
 	OrganizationStub        func(organizationID int32) (*models.Organization, error)
 	organizationMutex       sync.RWMutex
 	organizationArgsForCall []struct {
@@ -33,6 +74,101 @@ type FakeClient struct {
 		result1 *models.Organization
 		result2 error
 	}
+	OrganizationCtxStub        func(ctx context.Context, organizationID int32) (*models.Organization, error)
+	organizationCtxMutex       sync.RWMutex
+	organizationCtxArgsForCall []struct {
+		ctx            context.Context
+		organizationID int32
+	}
+	organizationCtxReturns struct {
+		result1 *models.Organization
+		result2 error
+	}
+	organizationCtxReturnsOnCall map[int]struct {
+		result1 *models.Organization
+		result2 error
+	}
+	CreateOrganizationStub        func(organization *models.Organization) (*models.Organization, error)
+	createOrganizationMutex       sync.RWMutex
+	createOrganizationArgsForCall []struct {
+		organization *models.Organization
+	}
+	createOrganizationReturns struct {
+		result1 *models.Organization
+		result2 error
+	}
+	createOrganizationReturnsOnCall map[int]struct {
+		result1 *models.Organization
+		result2 error
+	}
+	CreateOrganizationCtxStub        func(ctx context.Context, organization *models.Organization) (*models.Organization, error)
+	createOrganizationCtxMutex       sync.RWMutex
+	createOrganizationCtxArgsForCall []struct {
+		ctx          context.Context
+		organization *models.Organization
+	}
+	createOrganizationCtxReturns struct {
+		result1 *models.Organization
+		result2 error
+	}
+	createOrganizationCtxReturnsOnCall map[int]struct {
+		result1 *models.Organization
+		result2 error
+	}
+	UpdateOrganizationStub        func(organization *models.Organization) (*models.Organization, error)
+	updateOrganizationMutex       sync.RWMutex
+	updateOrganizationArgsForCall []struct {
+		organization *models.Organization
+	}
+	updateOrganizationReturns struct {
+		result1 *models.Organization
+		result2 error
+	}
+	updateOrganizationReturnsOnCall map[int]struct {
+		result1 *models.Organization
+		result2 error
+	}
+	UpdateOrganizationCtxStub        func(ctx context.Context, organization *models.Organization) (*models.Organization, error)
+	updateOrganizationCtxMutex       sync.RWMutex
+	updateOrganizationCtxArgsForCall []struct {
+		ctx          context.Context
+		organization *models.Organization
+	}
+	updateOrganizationCtxReturns struct {
+		result1 *models.Organization
+		result2 error
+	}
+	updateOrganizationCtxReturnsOnCall map[int]struct {
+		result1 *models.Organization
+		result2 error
+	}
+	DeactivateOrganizationStub        func(organizationID int32) (*models.Organization, error)
+	deactivateOrganizationMutex       sync.RWMutex
+	deactivateOrganizationArgsForCall []struct {
+		organizationID int32
+	}
+	deactivateOrganizationReturns struct {
+		result1 *models.Organization
+		result2 error
+	}
+	deactivateOrganizationReturnsOnCall map[int]struct {
+		result1 *models.Organization
+		result2 error
+	}
+	DeactivateOrganizationCtxStub        func(ctx context.Context, organizationID int32) (*models.Organization, error)
+	deactivateOrganizationCtxMutex       sync.RWMutex
+	deactivateOrganizationCtxArgsForCall []struct {
+		ctx            context.Context
+		organizationID int32
+	}
+	deactivateOrganizationCtxReturns struct {
+		result1 *models.Organization
+		result2 error
+	}
+	deactivateOrganizationCtxReturnsOnCall map[int]struct {
+		result1 *models.Organization
+		result2 error
+	}
 	SubscriptionsStub        func(limit *int32) ([]*models.Subscription, error)
 	subscriptionsMutex       sync.RWMutex
 	subscriptionsArgsForCall []struct {
@@ -46,6 +182,103 @@ type FakeClient struct {
 		result1 []*models.Subscription
 		result2 error
 	}
+	SubscriptionsCtxStub        func(ctx context.Context, limit *int32) ([]*models.Subscription, error)
+	subscriptionsCtxMutex       sync.RWMutex
+	subscriptionsCtxArgsForCall []struct {
+		ctx   context.Context
+		limit *int32
+	}
+	subscriptionsCtxReturns struct {
+		result1 []*models.Subscription
+		result2 error
+	}
+	subscriptionsCtxReturnsOnCall map[int]struct {
+		result1 []*models.Subscription
+		result2 error
+	}
+	SubscriptionsWithOptionsStub        func(options organization.ListSubscriptionsOptions) ([]*models.Subscription, error)
+	subscriptionsWithOptionsMutex       sync.RWMutex
+	subscriptionsWithOptionsArgsForCall []struct {
+		options organization.ListSubscriptionsOptions
+	}
+	subscriptionsWithOptionsReturns struct {
+		result1 []*models.Subscription
+		result2 error
+	}
+	subscriptionsWithOptionsReturnsOnCall map[int]struct {
+		result1 []*models.Subscription
+		result2 error
+	}
+	SubscriptionsWithOptionsCtxStub        func(ctx context.Context, options organization.ListSubscriptionsOptions) ([]*models.Subscription, error)
+	subscriptionsWithOptionsCtxMutex       sync.RWMutex
+	subscriptionsWithOptionsCtxArgsForCall []struct {
+		ctx     context.Context
+		options organization.ListSubscriptionsOptions
+	}
+	subscriptionsWithOptionsCtxReturns struct {
+		result1 []*models.Subscription
+		result2 error
+	}
+	subscriptionsWithOptionsCtxReturnsOnCall map[int]struct {
+		result1 []*models.Subscription
+		result2 error
+	}
+	OrganizationSubscriptionsStub        func(organizationID int32) ([]*models.Subscription, error)
+	organizationSubscriptionsMutex       sync.RWMutex
+	organizationSubscriptionsArgsForCall []struct {
+		organizationID int32
+	}
+	organizationSubscriptionsReturns struct {
+		result1 []*models.Subscription
+		result2 error
+	}
+	organizationSubscriptionsReturnsOnCall map[int]struct {
+		result1 []*models.Subscription
+		result2 error
+	}
+	OrganizationSubscriptionsCtxStub        func(ctx context.Context, organizationID int32) ([]*models.Subscription, error)
+	organizationSubscriptionsCtxMutex       sync.RWMutex
+	organizationSubscriptionsCtxArgsForCall []struct {
+		ctx            context.Context
+		organizationID int32
+	}
+	organizationSubscriptionsCtxReturns struct {
+		result1 []*models.Subscription
+		result2 error
+	}
+	organizationSubscriptionsCtxReturnsOnCall map[int]struct {
+		result1 []*models.Subscription
+		result2 error
+	}
+	SubscriptionStub        func(organizationID, subscriptionID int32) (*models.Subscription, error)
+	subscriptionMutex       sync.RWMutex
+	subscriptionArgsForCall []struct {
+		organizationID int32
+		subscriptionID int32
+	}
+	subscriptionReturns struct {
+		result1 *models.Subscription
+		result2 error
+	}
+	subscriptionReturnsOnCall map[int]struct {
+		result1 *models.Subscription
+		result2 error
+	}
+	SubscriptionCtxStub        func(ctx context.Context, organizationID, subscriptionID int32) (*models.Subscription, error)
+	subscriptionCtxMutex       sync.RWMutex
+	subscriptionCtxArgsForCall []struct {
+		ctx            context.Context
+		organizationID int32
+		subscriptionID int32
+	}
+	subscriptionCtxReturns struct {
+		result1 *models.Subscription
+		result2 error
+	}
+	subscriptionCtxReturnsOnCall map[int]struct {
+		result1 *models.Subscription
+		result2 error
+	}
 	UpdateSubscriptionStub        func(subscription *models.Subscription) (a *models.Subscription, err error)
 	updateSubscriptionMutex       sync.RWMutex
 	updateSubscriptionArgsForCall []struct {
@@ -59,6 +292,113 @@ type FakeClient struct {
 		result1 *models.Subscription
 		result2 error
 	}
+	UpdateSubscriptionCtxStub        func(ctx context.Context, subscription *models.Subscription) (a *models.Subscription, err error)
+	updateSubscriptionCtxMutex       sync.RWMutex
+	updateSubscriptionCtxArgsForCall []struct {
+		ctx          context.Context
+		subscription *models.Subscription
+	}
+	updateSubscriptionCtxReturns struct {
+		result1 *models.Subscription
+		result2 error
+	}
+	updateSubscriptionCtxReturnsOnCall map[int]struct {
+		result1 *models.Subscription
+		result2 error
+	}
+	CreateSubscriptionStub        func(organizationID, planID int32, paymentMethod string) (*models.Subscription, error)
+	createSubscriptionMutex       sync.RWMutex
+	createSubscriptionArgsForCall []struct {
+		organizationID int32
+		planID         int32
+		paymentMethod  string
+	}
+	createSubscriptionReturns struct {
+		result1 *models.Subscription
+		result2 error
+	}
+	createSubscriptionReturnsOnCall map[int]struct {
+		result1 *models.Subscription
+		result2 error
+	}
+	CreateSubscriptionCtxStub        func(ctx context.Context, organizationID, planID int32, paymentMethod string) (*models.Subscription, error)
+	createSubscriptionCtxMutex       sync.RWMutex
+	createSubscriptionCtxArgsForCall []struct {
+		ctx            context.Context
+		organizationID int32
+		planID         int32
+		paymentMethod  string
+	}
+	createSubscriptionCtxReturns struct {
+		result1 *models.Subscription
+		result2 error
+	}
+	createSubscriptionCtxReturnsOnCall map[int]struct {
+		result1 *models.Subscription
+		result2 error
+	}
+	CancelSubscriptionStub        func(organizationID, subscriptionID int32, canceledBy string) (*models.Subscription, error)
+	cancelSubscriptionMutex       sync.RWMutex
+	cancelSubscriptionArgsForCall []struct {
+		organizationID int32
+		subscriptionID int32
+		canceledBy     string
+	}
+	cancelSubscriptionReturns struct {
+		result1 *models.Subscription
+		result2 error
+	}
+	cancelSubscriptionReturnsOnCall map[int]struct {
+		result1 *models.Subscription
+		result2 error
+	}
+	CancelSubscriptionCtxStub        func(ctx context.Context, organizationID, subscriptionID int32, canceledBy string) (*models.Subscription, error)
+	cancelSubscriptionCtxMutex       sync.RWMutex
+	cancelSubscriptionCtxArgsForCall []struct {
+		ctx            context.Context
+		organizationID int32
+		subscriptionID int32
+		canceledBy     string
+	}
+	cancelSubscriptionCtxReturns struct {
+		result1 *models.Subscription
+		result2 error
+	}
+	cancelSubscriptionCtxReturnsOnCall map[int]struct {
+		result1 *models.Subscription
+		result2 error
+	}
+	ReactivateSubscriptionStub        func(organizationID, subscriptionID int32, reactivatedBy string) (*models.Subscription, error)
+	reactivateSubscriptionMutex       sync.RWMutex
+	reactivateSubscriptionArgsForCall []struct {
+		organizationID int32
+		subscriptionID int32
+		reactivatedBy  string
+	}
+	reactivateSubscriptionReturns struct {
+		result1 *models.Subscription
+		result2 error
+	}
+	reactivateSubscriptionReturnsOnCall map[int]struct {
+		result1 *models.Subscription
+		result2 error
+	}
+	ReactivateSubscriptionCtxStub        func(ctx context.Context, organizationID, subscriptionID int32, reactivatedBy string) (*models.Subscription, error)
+	reactivateSubscriptionCtxMutex       sync.RWMutex
+	reactivateSubscriptionCtxArgsForCall []struct {
+		ctx            context.Context
+		organizationID int32
+		subscriptionID int32
+		reactivatedBy  string
+	}
+	reactivateSubscriptionCtxReturns struct {
+		result1 *models.Subscription
+		result2 error
+	}
+	reactivateSubscriptionCtxReturnsOnCall map[int]struct {
+		result1 *models.Subscription
+		result2 error
+	}
 	PlanStub        func(planID int32) (org *models.Plan, err error)
 	planMutex       sync.RWMutex
 	planArgsForCall []struct {
@@ -72,6 +412,47 @@ type FakeClient struct {
 		result1 *models.Plan
 		result2 error
 	}
+	PlanCtxStub        func(ctx context.Context, planID int32) (org *models.Plan, err error)
+	planCtxMutex       sync.RWMutex
+	planCtxArgsForCall []struct {
+		ctx    context.Context
+		planID int32
+	}
+	planCtxReturns struct {
+		result1 *models.Plan
+		result2 error
+	}
+	planCtxReturnsOnCall map[int]struct {
+		result1 *models.Plan
+		result2 error
+	}
+	PlansStub        func(options organization.ListPlansOptions) ([]*models.Plan, error)
+	plansMutex       sync.RWMutex
+	plansArgsForCall []struct {
+		options organization.ListPlansOptions
+	}
+	plansReturns struct {
+		result1 []*models.Plan
+		result2 error
+	}
+	plansReturnsOnCall map[int]struct {
+		result1 []*models.Plan
+		result2 error
+	}
+	PlansCtxStub        func(ctx context.Context, options organization.ListPlansOptions) ([]*models.Plan, error)
+	plansCtxMutex       sync.RWMutex
+	plansCtxArgsForCall []struct {
+		ctx     context.Context
+		options organization.ListPlansOptions
+	}
+	plansCtxReturns struct {
+		result1 []*models.Plan
+		result2 error
+	}
+	plansCtxReturnsOnCall map[int]struct {
+		result1 []*models.Plan
+		result2 error
+	}
 	OrganizationUsersStub        func(organizationID int32) (users []*models.User, err error)
 	organizationUsersMutex       sync.RWMutex
 	organizationUsersArgsForCall []struct {
@@ -85,6 +466,78 @@ type FakeClient struct {
 		result1 []*models.User
 		result2 error
 	}
+	OrganizationUsersCtxStub        func(ctx context.Context, organizationID int32) (users []*models.User, err error)
+	organizationUsersCtxMutex       sync.RWMutex
+	organizationUsersCtxArgsForCall []struct {
+		ctx            context.Context
+		organizationID int32
+	}
+	organizationUsersCtxReturns struct {
+		result1 []*models.User
+		result2 error
+	}
+	organizationUsersCtxReturnsOnCall map[int]struct {
+		result1 []*models.User
+		result2 error
+	}
+	CreateOrganizationUserStub        func(organizationID int32, user *models.UserPost) (*models.User, error)
+	createOrganizationUserMutex       sync.RWMutex
+	createOrganizationUserArgsForCall []struct {
+		organizationID int32
+		user           *models.UserPost
+	}
+	createOrganizationUserReturns struct {
+		result1 *models.User
+		result2 error
+	}
+	createOrganizationUserReturnsOnCall map[int]struct {
+		result1 *models.User
+		result2 error
+	}
+	CreateOrganizationUserCtxStub        func(ctx context.Context, organizationID int32, user *models.UserPost) (*models.User, error)
+	createOrganizationUserCtxMutex       sync.RWMutex
+	createOrganizationUserCtxArgsForCall []struct {
+		ctx            context.Context
+		organizationID int32
+		user           *models.UserPost
+	}
+	createOrganizationUserCtxReturns struct {
+		result1 *models.User
+		result2 error
+	}
+	createOrganizationUserCtxReturnsOnCall map[int]struct {
+		result1 *models.User
+		result2 error
+	}
+	ImpersonationURLStub        func(userID string, options organization.ImpersonationOptions) (*models.ImpersonateURL, error)
+	impersonationURLMutex       sync.RWMutex
+	impersonationURLArgsForCall []struct {
+		userID  string
+		options organization.ImpersonationOptions
+	}
+	impersonationURLReturns struct {
+		result1 *models.ImpersonateURL
+		result2 error
+	}
+	impersonationURLReturnsOnCall map[int]struct {
+		result1 *models.ImpersonateURL
+		result2 error
+	}
+	ImpersonationURLCtxStub        func(ctx context.Context, userID string, options organization.ImpersonationOptions) (*models.ImpersonateURL, error)
+	impersonationURLCtxMutex       sync.RWMutex
+	impersonationURLCtxArgsForCall []struct {
+		ctx     context.Context
+		userID  string
+		options organization.ImpersonationOptions
+	}
+	impersonationURLCtxReturns struct {
+		result1 *models.ImpersonateURL
+		result2 error
+	}
+	impersonationURLCtxReturnsOnCall map[int]struct {
+		result1 *models.ImpersonateURL
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -132,154 +585,1405 @@ func (fake *FakeClient) OrganizationsReturnsOnCall(i int, result1 []*models.Orga
 	}{result1, result2}
 }
 
-func (fake *FakeClient) Organization(organizationID int32) (*models.Organization, error) {
-	fake.organizationMutex.Lock()
-	ret, specificReturn := fake.organizationReturnsOnCall[len(fake.organizationArgsForCall)]
-	fake.organizationArgsForCall = append(fake.organizationArgsForCall, struct {
-		organizationID int32
-	}{organizationID})
-	fake.recordInvocation("Organization", []interface{}{organizationID})
-	fake.organizationMutex.Unlock()
-	if fake.OrganizationStub != nil {
-		return fake.OrganizationStub(organizationID)
+func (fake *FakeClient) OrganizationsCtx(ctx context.Context) ([]*models.Organization, error) {
+	fake.organizationsCtxMutex.Lock()
+	ret, specificReturn := fake.organizationsCtxReturnsOnCall[len(fake.organizationsCtxArgsForCall)]
+	fake.organizationsCtxArgsForCall = append(fake.organizationsCtxArgsForCall, struct {
+		ctx context.Context
+	}{ctx})
+	fake.recordInvocation("OrganizationsCtx", []interface{}{ctx})
+	fake.organizationsCtxMutex.Unlock()
+	if fake.OrganizationsCtxStub != nil {
+		return fake.OrganizationsCtxStub(ctx)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	return fake.organizationReturns.result1, fake.organizationReturns.result2
+	return fake.organizationsCtxReturns.result1, fake.organizationsCtxReturns.result2
 }
 
-func (fake *FakeClient) OrganizationCallCount() int {
-	fake.organizationMutex.RLock()
-	defer fake.organizationMutex.RUnlock()
-	return len(fake.organizationArgsForCall)
+func (fake *FakeClient) OrganizationsCtxCallCount() int {
+	fake.organizationsCtxMutex.RLock()
+	defer fake.organizationsCtxMutex.RUnlock()
+	return len(fake.organizationsCtxArgsForCall)
 }
 
-func (fake *FakeClient) OrganizationArgsForCall(i int) int32 {
-	fake.organizationMutex.RLock()
-	defer fake.organizationMutex.RUnlock()
-	return fake.organizationArgsForCall[i].organizationID
+func (fake *FakeClient) OrganizationsCtxArgsForCall(i int) context.Context {
+	fake.organizationsCtxMutex.RLock()
+	defer fake.organizationsCtxMutex.RUnlock()
+	return fake.organizationsCtxArgsForCall[i].ctx
+}
+
+func (fake *FakeClient) OrganizationsCtxReturns(result1 []*models.Organization, result2 error) {
+	fake.OrganizationsCtxStub = nil
+	fake.organizationsCtxReturns = struct {
+		result1 []*models.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) OrganizationsCtxReturnsOnCall(i int, result1 []*models.Organization, result2 error) {
+	fake.OrganizationsCtxStub = nil
+	if fake.organizationsCtxReturnsOnCall == nil {
+		fake.organizationsCtxReturnsOnCall = make(map[int]struct {
+			result1 []*models.Organization
+			result2 error
+		})
+	}
+	fake.organizationsCtxReturnsOnCall[i] = struct {
+		result1 []*models.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) OrganizationsWithOptions(options organization.ListOrganizationsOptions) ([]*models.Organization, error) {
+	fake.organizationsWithOptionsMutex.Lock()
+	ret, specificReturn := fake.organizationsWithOptionsReturnsOnCall[len(fake.organizationsWithOptionsArgsForCall)]
+	fake.organizationsWithOptionsArgsForCall = append(fake.organizationsWithOptionsArgsForCall, struct {
+		options organization.ListOrganizationsOptions
+	}{options})
+	fake.recordInvocation("OrganizationsWithOptions", []interface{}{options})
+	fake.organizationsWithOptionsMutex.Unlock()
+	if fake.OrganizationsWithOptionsStub != nil {
+		return fake.OrganizationsWithOptionsStub(options)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.organizationsWithOptionsReturns.result1, fake.organizationsWithOptionsReturns.result2
+}
+
+func (fake *FakeClient) OrganizationsWithOptionsCallCount() int {
+	fake.organizationsWithOptionsMutex.RLock()
+	defer fake.organizationsWithOptionsMutex.RUnlock()
+	return len(fake.organizationsWithOptionsArgsForCall)
+}
+
+func (fake *FakeClient) OrganizationsWithOptionsArgsForCall(i int) organization.ListOrganizationsOptions {
+	fake.organizationsWithOptionsMutex.RLock()
+	defer fake.organizationsWithOptionsMutex.RUnlock()
+	return fake.organizationsWithOptionsArgsForCall[i].options
+}
+
+func (fake *FakeClient) OrganizationsWithOptionsReturns(result1 []*models.Organization, result2 error) {
+	fake.OrganizationsWithOptionsStub = nil
+	fake.organizationsWithOptionsReturns = struct {
+		result1 []*models.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) OrganizationsWithOptionsReturnsOnCall(i int, result1 []*models.Organization, result2 error) {
+	fake.OrganizationsWithOptionsStub = nil
+	if fake.organizationsWithOptionsReturnsOnCall == nil {
+		fake.organizationsWithOptionsReturnsOnCall = make(map[int]struct {
+			result1 []*models.Organization
+			result2 error
+		})
+	}
+	fake.organizationsWithOptionsReturnsOnCall[i] = struct {
+		result1 []*models.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) OrganizationsWithOptionsCtx(ctx context.Context, options organization.ListOrganizationsOptions) ([]*models.Organization, error) {
+	fake.organizationsWithOptionsCtxMutex.Lock()
+	ret, specificReturn := fake.organizationsWithOptionsCtxReturnsOnCall[len(fake.organizationsWithOptionsCtxArgsForCall)]
+	fake.organizationsWithOptionsCtxArgsForCall = append(fake.organizationsWithOptionsCtxArgsForCall, struct {
+		ctx     context.Context
+		options organization.ListOrganizationsOptions
+	}{ctx, options})
+	fake.recordInvocation("OrganizationsWithOptionsCtx", []interface{}{ctx, options})
+	fake.organizationsWithOptionsCtxMutex.Unlock()
+	if fake.OrganizationsWithOptionsCtxStub != nil {
+		return fake.OrganizationsWithOptionsCtxStub(ctx, options)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.organizationsWithOptionsCtxReturns.result1, fake.organizationsWithOptionsCtxReturns.result2
+}
+
+func (fake *FakeClient) OrganizationsWithOptionsCtxCallCount() int {
+	fake.organizationsWithOptionsCtxMutex.RLock()
+	defer fake.organizationsWithOptionsCtxMutex.RUnlock()
+	return len(fake.organizationsWithOptionsCtxArgsForCall)
+}
+
+func (fake *FakeClient) OrganizationsWithOptionsCtxArgsForCall(i int) (context.Context, organization.ListOrganizationsOptions) {
+	fake.organizationsWithOptionsCtxMutex.RLock()
+	defer fake.organizationsWithOptionsCtxMutex.RUnlock()
+	return fake.organizationsWithOptionsCtxArgsForCall[i].ctx, fake.organizationsWithOptionsCtxArgsForCall[i].options
+}
+
+func (fake *FakeClient) OrganizationsWithOptionsCtxReturns(result1 []*models.Organization, result2 error) {
+	fake.OrganizationsWithOptionsCtxStub = nil
+	fake.organizationsWithOptionsCtxReturns = struct {
+		result1 []*models.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) OrganizationsWithOptionsCtxReturnsOnCall(i int, result1 []*models.Organization, result2 error) {
+	fake.OrganizationsWithOptionsCtxStub = nil
+	if fake.organizationsWithOptionsCtxReturnsOnCall == nil {
+		fake.organizationsWithOptionsCtxReturnsOnCall = make(map[int]struct {
+			result1 []*models.Organization
+			result2 error
+		})
+	}
+	fake.organizationsWithOptionsCtxReturnsOnCall[i] = struct {
+		result1 []*models.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) Organization(organizationID int32) (*models.Organization, error) {
+	fake.organizationMutex.Lock()
+	ret, specificReturn := fake.organizationReturnsOnCall[len(fake.organizationArgsForCall)]
+	fake.organizationArgsForCall = append(fake.organizationArgsForCall, struct {
+		organizationID int32
+	}{organizationID})
+	fake.recordInvocation("Organization", []interface{}{organizationID})
+	fake.organizationMutex.Unlock()
+	if fake.OrganizationStub != nil {
+		return fake.OrganizationStub(organizationID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.organizationReturns.result1, fake.organizationReturns.result2
+}
+
+func (fake *FakeClient) OrganizationCallCount() int {
+	fake.organizationMutex.RLock()
+	defer fake.organizationMutex.RUnlock()
+	return len(fake.organizationArgsForCall)
+}
+
+func (fake *FakeClient) OrganizationArgsForCall(i int) int32 {
+	fake.organizationMutex.RLock()
+	defer fake.organizationMutex.RUnlock()
+	return fake.organizationArgsForCall[i].organizationID
+}
+
+func (fake *FakeClient) OrganizationReturns(result1 *models.Organization, result2 error) {
+	fake.OrganizationStub = nil
+	fake.organizationReturns = struct {
+		result1 *models.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) OrganizationReturnsOnCall(i int, result1 *models.Organization, result2 error) {
+	fake.OrganizationStub = nil
+	if fake.organizationReturnsOnCall == nil {
+		fake.organizationReturnsOnCall = make(map[int]struct {
+			result1 *models.Organization
+			result2 error
+		})
+	}
+	fake.organizationReturnsOnCall[i] = struct {
+		result1 *models.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) OrganizationCtx(ctx context.Context, organizationID int32) (*models.Organization, error) {
+	fake.organizationCtxMutex.Lock()
+	ret, specificReturn := fake.organizationCtxReturnsOnCall[len(fake.organizationCtxArgsForCall)]
+	fake.organizationCtxArgsForCall = append(fake.organizationCtxArgsForCall, struct {
+		ctx            context.Context
+		organizationID int32
+	}{ctx, organizationID})
+	fake.recordInvocation("OrganizationCtx", []interface{}{ctx, organizationID})
+	fake.organizationCtxMutex.Unlock()
+	if fake.OrganizationCtxStub != nil {
+		return fake.OrganizationCtxStub(ctx, organizationID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.organizationCtxReturns.result1, fake.organizationCtxReturns.result2
+}
+
+func (fake *FakeClient) OrganizationCtxCallCount() int {
+	fake.organizationCtxMutex.RLock()
+	defer fake.organizationCtxMutex.RUnlock()
+	return len(fake.organizationCtxArgsForCall)
+}
+
+func (fake *FakeClient) OrganizationCtxArgsForCall(i int) (context.Context, int32) {
+	fake.organizationCtxMutex.RLock()
+	defer fake.organizationCtxMutex.RUnlock()
+	return fake.organizationCtxArgsForCall[i].ctx, fake.organizationCtxArgsForCall[i].organizationID
+}
+
+func (fake *FakeClient) OrganizationCtxReturns(result1 *models.Organization, result2 error) {
+	fake.OrganizationCtxStub = nil
+	fake.organizationCtxReturns = struct {
+		result1 *models.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) OrganizationCtxReturnsOnCall(i int, result1 *models.Organization, result2 error) {
+	fake.OrganizationCtxStub = nil
+	if fake.organizationCtxReturnsOnCall == nil {
+		fake.organizationCtxReturnsOnCall = make(map[int]struct {
+			result1 *models.Organization
+			result2 error
+		})
+	}
+	fake.organizationCtxReturnsOnCall[i] = struct {
+		result1 *models.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreateOrganization(organization *models.Organization) (*models.Organization, error) {
+	fake.createOrganizationMutex.Lock()
+	ret, specificReturn := fake.createOrganizationReturnsOnCall[len(fake.createOrganizationArgsForCall)]
+	fake.createOrganizationArgsForCall = append(fake.createOrganizationArgsForCall, struct {
+		organization *models.Organization
+	}{organization})
+	fake.recordInvocation("CreateOrganization", []interface{}{organization})
+	fake.createOrganizationMutex.Unlock()
+	if fake.CreateOrganizationStub != nil {
+		return fake.CreateOrganizationStub(organization)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.createOrganizationReturns.result1, fake.createOrganizationReturns.result2
+}
+
+func (fake *FakeClient) CreateOrganizationCallCount() int {
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	return len(fake.createOrganizationArgsForCall)
+}
+
+func (fake *FakeClient) CreateOrganizationArgsForCall(i int) *models.Organization {
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	return fake.createOrganizationArgsForCall[i].organization
+}
+
+func (fake *FakeClient) CreateOrganizationReturns(result1 *models.Organization, result2 error) {
+	fake.CreateOrganizationStub = nil
+	fake.createOrganizationReturns = struct {
+		result1 *models.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreateOrganizationReturnsOnCall(i int, result1 *models.Organization, result2 error) {
+	fake.CreateOrganizationStub = nil
+	if fake.createOrganizationReturnsOnCall == nil {
+		fake.createOrganizationReturnsOnCall = make(map[int]struct {
+			result1 *models.Organization
+			result2 error
+		})
+	}
+	fake.createOrganizationReturnsOnCall[i] = struct {
+		result1 *models.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreateOrganizationCtx(ctx context.Context, organization *models.Organization) (*models.Organization, error) {
+	fake.createOrganizationCtxMutex.Lock()
+	ret, specificReturn := fake.createOrganizationCtxReturnsOnCall[len(fake.createOrganizationCtxArgsForCall)]
+	fake.createOrganizationCtxArgsForCall = append(fake.createOrganizationCtxArgsForCall, struct {
+		ctx          context.Context
+		organization *models.Organization
+	}{ctx, organization})
+	fake.recordInvocation("CreateOrganizationCtx", []interface{}{ctx, organization})
+	fake.createOrganizationCtxMutex.Unlock()
+	if fake.CreateOrganizationCtxStub != nil {
+		return fake.CreateOrganizationCtxStub(ctx, organization)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.createOrganizationCtxReturns.result1, fake.createOrganizationCtxReturns.result2
+}
+
+func (fake *FakeClient) CreateOrganizationCtxCallCount() int {
+	fake.createOrganizationCtxMutex.RLock()
+	defer fake.createOrganizationCtxMutex.RUnlock()
+	return len(fake.createOrganizationCtxArgsForCall)
+}
+
+func (fake *FakeClient) CreateOrganizationCtxArgsForCall(i int) (context.Context, *models.Organization) {
+	fake.createOrganizationCtxMutex.RLock()
+	defer fake.createOrganizationCtxMutex.RUnlock()
+	return fake.createOrganizationCtxArgsForCall[i].ctx, fake.createOrganizationCtxArgsForCall[i].organization
+}
+
+func (fake *FakeClient) CreateOrganizationCtxReturns(result1 *models.Organization, result2 error) {
+	fake.CreateOrganizationCtxStub = nil
+	fake.createOrganizationCtxReturns = struct {
+		result1 *models.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreateOrganizationCtxReturnsOnCall(i int, result1 *models.Organization, result2 error) {
+	fake.CreateOrganizationCtxStub = nil
+	if fake.createOrganizationCtxReturnsOnCall == nil {
+		fake.createOrganizationCtxReturnsOnCall = make(map[int]struct {
+			result1 *models.Organization
+			result2 error
+		})
+	}
+	fake.createOrganizationCtxReturnsOnCall[i] = struct {
+		result1 *models.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UpdateOrganization(organization *models.Organization) (*models.Organization, error) {
+	fake.updateOrganizationMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationReturnsOnCall[len(fake.updateOrganizationArgsForCall)]
+	fake.updateOrganizationArgsForCall = append(fake.updateOrganizationArgsForCall, struct {
+		organization *models.Organization
+	}{organization})
+	fake.recordInvocation("UpdateOrganization", []interface{}{organization})
+	fake.updateOrganizationMutex.Unlock()
+	if fake.UpdateOrganizationStub != nil {
+		return fake.UpdateOrganizationStub(organization)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateOrganizationReturns.result1, fake.updateOrganizationReturns.result2
+}
+
+func (fake *FakeClient) UpdateOrganizationCallCount() int {
+	fake.updateOrganizationMutex.RLock()
+	defer fake.updateOrganizationMutex.RUnlock()
+	return len(fake.updateOrganizationArgsForCall)
+}
+
+func (fake *FakeClient) UpdateOrganizationArgsForCall(i int) *models.Organization {
+	fake.updateOrganizationMutex.RLock()
+	defer fake.updateOrganizationMutex.RUnlock()
+	return fake.updateOrganizationArgsForCall[i].organization
+}
+
+func (fake *FakeClient) UpdateOrganizationReturns(result1 *models.Organization, result2 error) {
+	fake.UpdateOrganizationStub = nil
+	fake.updateOrganizationReturns = struct {
+		result1 *models.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UpdateOrganizationReturnsOnCall(i int, result1 *models.Organization, result2 error) {
+	fake.UpdateOrganizationStub = nil
+	if fake.updateOrganizationReturnsOnCall == nil {
+		fake.updateOrganizationReturnsOnCall = make(map[int]struct {
+			result1 *models.Organization
+			result2 error
+		})
+	}
+	fake.updateOrganizationReturnsOnCall[i] = struct {
+		result1 *models.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UpdateOrganizationCtx(ctx context.Context, organization *models.Organization) (*models.Organization, error) {
+	fake.updateOrganizationCtxMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationCtxReturnsOnCall[len(fake.updateOrganizationCtxArgsForCall)]
+	fake.updateOrganizationCtxArgsForCall = append(fake.updateOrganizationCtxArgsForCall, struct {
+		ctx          context.Context
+		organization *models.Organization
+	}{ctx, organization})
+	fake.recordInvocation("UpdateOrganizationCtx", []interface{}{ctx, organization})
+	fake.updateOrganizationCtxMutex.Unlock()
+	if fake.UpdateOrganizationCtxStub != nil {
+		return fake.UpdateOrganizationCtxStub(ctx, organization)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateOrganizationCtxReturns.result1, fake.updateOrganizationCtxReturns.result2
+}
+
+func (fake *FakeClient) UpdateOrganizationCtxCallCount() int {
+	fake.updateOrganizationCtxMutex.RLock()
+	defer fake.updateOrganizationCtxMutex.RUnlock()
+	return len(fake.updateOrganizationCtxArgsForCall)
+}
+
+func (fake *FakeClient) UpdateOrganizationCtxArgsForCall(i int) (context.Context, *models.Organization) {
+	fake.updateOrganizationCtxMutex.RLock()
+	defer fake.updateOrganizationCtxMutex.RUnlock()
+	return fake.updateOrganizationCtxArgsForCall[i].ctx, fake.updateOrganizationCtxArgsForCall[i].organization
+}
+
+func (fake *FakeClient) UpdateOrganizationCtxReturns(result1 *models.Organization, result2 error) {
+	fake.UpdateOrganizationCtxStub = nil
+	fake.updateOrganizationCtxReturns = struct {
+		result1 *models.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UpdateOrganizationCtxReturnsOnCall(i int, result1 *models.Organization, result2 error) {
+	fake.UpdateOrganizationCtxStub = nil
+	if fake.updateOrganizationCtxReturnsOnCall == nil {
+		fake.updateOrganizationCtxReturnsOnCall = make(map[int]struct {
+			result1 *models.Organization
+			result2 error
+		})
+	}
+	fake.updateOrganizationCtxReturnsOnCall[i] = struct {
+		result1 *models.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DeactivateOrganization(organizationID int32) (*models.Organization, error) {
+	fake.deactivateOrganizationMutex.Lock()
+	ret, specificReturn := fake.deactivateOrganizationReturnsOnCall[len(fake.deactivateOrganizationArgsForCall)]
+	fake.deactivateOrganizationArgsForCall = append(fake.deactivateOrganizationArgsForCall, struct {
+		organizationID int32
+	}{organizationID})
+	fake.recordInvocation("DeactivateOrganization", []interface{}{organizationID})
+	fake.deactivateOrganizationMutex.Unlock()
+	if fake.DeactivateOrganizationStub != nil {
+		return fake.DeactivateOrganizationStub(organizationID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deactivateOrganizationReturns.result1, fake.deactivateOrganizationReturns.result2
+}
+
+func (fake *FakeClient) DeactivateOrganizationCallCount() int {
+	fake.deactivateOrganizationMutex.RLock()
+	defer fake.deactivateOrganizationMutex.RUnlock()
+	return len(fake.deactivateOrganizationArgsForCall)
+}
+
+func (fake *FakeClient) DeactivateOrganizationArgsForCall(i int) int32 {
+	fake.deactivateOrganizationMutex.RLock()
+	defer fake.deactivateOrganizationMutex.RUnlock()
+	return fake.deactivateOrganizationArgsForCall[i].organizationID
+}
+
+func (fake *FakeClient) DeactivateOrganizationReturns(result1 *models.Organization, result2 error) {
+	fake.DeactivateOrganizationStub = nil
+	fake.deactivateOrganizationReturns = struct {
+		result1 *models.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DeactivateOrganizationReturnsOnCall(i int, result1 *models.Organization, result2 error) {
+	fake.DeactivateOrganizationStub = nil
+	if fake.deactivateOrganizationReturnsOnCall == nil {
+		fake.deactivateOrganizationReturnsOnCall = make(map[int]struct {
+			result1 *models.Organization
+			result2 error
+		})
+	}
+	fake.deactivateOrganizationReturnsOnCall[i] = struct {
+		result1 *models.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DeactivateOrganizationCtx(ctx context.Context, organizationID int32) (*models.Organization, error) {
+	fake.deactivateOrganizationCtxMutex.Lock()
+	ret, specificReturn := fake.deactivateOrganizationCtxReturnsOnCall[len(fake.deactivateOrganizationCtxArgsForCall)]
+	fake.deactivateOrganizationCtxArgsForCall = append(fake.deactivateOrganizationCtxArgsForCall, struct {
+		ctx            context.Context
+		organizationID int32
+	}{ctx, organizationID})
+	fake.recordInvocation("DeactivateOrganizationCtx", []interface{}{ctx, organizationID})
+	fake.deactivateOrganizationCtxMutex.Unlock()
+	if fake.DeactivateOrganizationCtxStub != nil {
+		return fake.DeactivateOrganizationCtxStub(ctx, organizationID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deactivateOrganizationCtxReturns.result1, fake.deactivateOrganizationCtxReturns.result2
+}
+
+func (fake *FakeClient) DeactivateOrganizationCtxCallCount() int {
+	fake.deactivateOrganizationCtxMutex.RLock()
+	defer fake.deactivateOrganizationCtxMutex.RUnlock()
+	return len(fake.deactivateOrganizationCtxArgsForCall)
+}
+
+func (fake *FakeClient) DeactivateOrganizationCtxArgsForCall(i int) (context.Context, int32) {
+	fake.deactivateOrganizationCtxMutex.RLock()
+	defer fake.deactivateOrganizationCtxMutex.RUnlock()
+	return fake.deactivateOrganizationCtxArgsForCall[i].ctx, fake.deactivateOrganizationCtxArgsForCall[i].organizationID
+}
+
+func (fake *FakeClient) DeactivateOrganizationCtxReturns(result1 *models.Organization, result2 error) {
+	fake.DeactivateOrganizationCtxStub = nil
+	fake.deactivateOrganizationCtxReturns = struct {
+		result1 *models.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DeactivateOrganizationCtxReturnsOnCall(i int, result1 *models.Organization, result2 error) {
+	fake.DeactivateOrganizationCtxStub = nil
+	if fake.deactivateOrganizationCtxReturnsOnCall == nil {
+		fake.deactivateOrganizationCtxReturnsOnCall = make(map[int]struct {
+			result1 *models.Organization
+			result2 error
+		})
+	}
+	fake.deactivateOrganizationCtxReturnsOnCall[i] = struct {
+		result1 *models.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) Subscriptions(limit *int32) ([]*models.Subscription, error) {
+	fake.subscriptionsMutex.Lock()
+	ret, specificReturn := fake.subscriptionsReturnsOnCall[len(fake.subscriptionsArgsForCall)]
+	fake.subscriptionsArgsForCall = append(fake.subscriptionsArgsForCall, struct {
+		limit *int32
+	}{limit})
+	fake.recordInvocation("Subscriptions", []interface{}{limit})
+	fake.subscriptionsMutex.Unlock()
+	if fake.SubscriptionsStub != nil {
+		return fake.SubscriptionsStub(limit)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.subscriptionsReturns.result1, fake.subscriptionsReturns.result2
+}
+
+func (fake *FakeClient) SubscriptionsCallCount() int {
+	fake.subscriptionsMutex.RLock()
+	defer fake.subscriptionsMutex.RUnlock()
+	return len(fake.subscriptionsArgsForCall)
+}
+
+func (fake *FakeClient) SubscriptionsArgsForCall(i int) *int32 {
+	fake.subscriptionsMutex.RLock()
+	defer fake.subscriptionsMutex.RUnlock()
+	return fake.subscriptionsArgsForCall[i].limit
+}
+
+func (fake *FakeClient) SubscriptionsReturns(result1 []*models.Subscription, result2 error) {
+	fake.SubscriptionsStub = nil
+	fake.subscriptionsReturns = struct {
+		result1 []*models.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SubscriptionsReturnsOnCall(i int, result1 []*models.Subscription, result2 error) {
+	fake.SubscriptionsStub = nil
+	if fake.subscriptionsReturnsOnCall == nil {
+		fake.subscriptionsReturnsOnCall = make(map[int]struct {
+			result1 []*models.Subscription
+			result2 error
+		})
+	}
+	fake.subscriptionsReturnsOnCall[i] = struct {
+		result1 []*models.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SubscriptionsCtx(ctx context.Context, limit *int32) ([]*models.Subscription, error) {
+	fake.subscriptionsCtxMutex.Lock()
+	ret, specificReturn := fake.subscriptionsCtxReturnsOnCall[len(fake.subscriptionsCtxArgsForCall)]
+	fake.subscriptionsCtxArgsForCall = append(fake.subscriptionsCtxArgsForCall, struct {
+		ctx   context.Context
+		limit *int32
+	}{ctx, limit})
+	fake.recordInvocation("SubscriptionsCtx", []interface{}{ctx, limit})
+	fake.subscriptionsCtxMutex.Unlock()
+	if fake.SubscriptionsCtxStub != nil {
+		return fake.SubscriptionsCtxStub(ctx, limit)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.subscriptionsCtxReturns.result1, fake.subscriptionsCtxReturns.result2
+}
+
+func (fake *FakeClient) SubscriptionsCtxCallCount() int {
+	fake.subscriptionsCtxMutex.RLock()
+	defer fake.subscriptionsCtxMutex.RUnlock()
+	return len(fake.subscriptionsCtxArgsForCall)
+}
+
+func (fake *FakeClient) SubscriptionsCtxArgsForCall(i int) (context.Context, *int32) {
+	fake.subscriptionsCtxMutex.RLock()
+	defer fake.subscriptionsCtxMutex.RUnlock()
+	return fake.subscriptionsCtxArgsForCall[i].ctx, fake.subscriptionsCtxArgsForCall[i].limit
+}
+
+func (fake *FakeClient) SubscriptionsCtxReturns(result1 []*models.Subscription, result2 error) {
+	fake.SubscriptionsCtxStub = nil
+	fake.subscriptionsCtxReturns = struct {
+		result1 []*models.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SubscriptionsCtxReturnsOnCall(i int, result1 []*models.Subscription, result2 error) {
+	fake.SubscriptionsCtxStub = nil
+	if fake.subscriptionsCtxReturnsOnCall == nil {
+		fake.subscriptionsCtxReturnsOnCall = make(map[int]struct {
+			result1 []*models.Subscription
+			result2 error
+		})
+	}
+	fake.subscriptionsCtxReturnsOnCall[i] = struct {
+		result1 []*models.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SubscriptionsWithOptions(options organization.ListSubscriptionsOptions) ([]*models.Subscription, error) {
+	fake.subscriptionsWithOptionsMutex.Lock()
+	ret, specificReturn := fake.subscriptionsWithOptionsReturnsOnCall[len(fake.subscriptionsWithOptionsArgsForCall)]
+	fake.subscriptionsWithOptionsArgsForCall = append(fake.subscriptionsWithOptionsArgsForCall, struct {
+		options organization.ListSubscriptionsOptions
+	}{options})
+	fake.recordInvocation("SubscriptionsWithOptions", []interface{}{options})
+	fake.subscriptionsWithOptionsMutex.Unlock()
+	if fake.SubscriptionsWithOptionsStub != nil {
+		return fake.SubscriptionsWithOptionsStub(options)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.subscriptionsWithOptionsReturns.result1, fake.subscriptionsWithOptionsReturns.result2
+}
+
+func (fake *FakeClient) SubscriptionsWithOptionsCallCount() int {
+	fake.subscriptionsWithOptionsMutex.RLock()
+	defer fake.subscriptionsWithOptionsMutex.RUnlock()
+	return len(fake.subscriptionsWithOptionsArgsForCall)
+}
+
+func (fake *FakeClient) SubscriptionsWithOptionsArgsForCall(i int) organization.ListSubscriptionsOptions {
+	fake.subscriptionsWithOptionsMutex.RLock()
+	defer fake.subscriptionsWithOptionsMutex.RUnlock()
+	return fake.subscriptionsWithOptionsArgsForCall[i].options
+}
+
+func (fake *FakeClient) SubscriptionsWithOptionsReturns(result1 []*models.Subscription, result2 error) {
+	fake.SubscriptionsWithOptionsStub = nil
+	fake.subscriptionsWithOptionsReturns = struct {
+		result1 []*models.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SubscriptionsWithOptionsReturnsOnCall(i int, result1 []*models.Subscription, result2 error) {
+	fake.SubscriptionsWithOptionsStub = nil
+	if fake.subscriptionsWithOptionsReturnsOnCall == nil {
+		fake.subscriptionsWithOptionsReturnsOnCall = make(map[int]struct {
+			result1 []*models.Subscription
+			result2 error
+		})
+	}
+	fake.subscriptionsWithOptionsReturnsOnCall[i] = struct {
+		result1 []*models.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SubscriptionsWithOptionsCtx(ctx context.Context, options organization.ListSubscriptionsOptions) ([]*models.Subscription, error) {
+	fake.subscriptionsWithOptionsCtxMutex.Lock()
+	ret, specificReturn := fake.subscriptionsWithOptionsCtxReturnsOnCall[len(fake.subscriptionsWithOptionsCtxArgsForCall)]
+	fake.subscriptionsWithOptionsCtxArgsForCall = append(fake.subscriptionsWithOptionsCtxArgsForCall, struct {
+		ctx     context.Context
+		options organization.ListSubscriptionsOptions
+	}{ctx, options})
+	fake.recordInvocation("SubscriptionsWithOptionsCtx", []interface{}{ctx, options})
+	fake.subscriptionsWithOptionsCtxMutex.Unlock()
+	if fake.SubscriptionsWithOptionsCtxStub != nil {
+		return fake.SubscriptionsWithOptionsCtxStub(ctx, options)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.subscriptionsWithOptionsCtxReturns.result1, fake.subscriptionsWithOptionsCtxReturns.result2
+}
+
+func (fake *FakeClient) SubscriptionsWithOptionsCtxCallCount() int {
+	fake.subscriptionsWithOptionsCtxMutex.RLock()
+	defer fake.subscriptionsWithOptionsCtxMutex.RUnlock()
+	return len(fake.subscriptionsWithOptionsCtxArgsForCall)
+}
+
+func (fake *FakeClient) SubscriptionsWithOptionsCtxArgsForCall(i int) (context.Context, organization.ListSubscriptionsOptions) {
+	fake.subscriptionsWithOptionsCtxMutex.RLock()
+	defer fake.subscriptionsWithOptionsCtxMutex.RUnlock()
+	return fake.subscriptionsWithOptionsCtxArgsForCall[i].ctx, fake.subscriptionsWithOptionsCtxArgsForCall[i].options
+}
+
+func (fake *FakeClient) SubscriptionsWithOptionsCtxReturns(result1 []*models.Subscription, result2 error) {
+	fake.SubscriptionsWithOptionsCtxStub = nil
+	fake.subscriptionsWithOptionsCtxReturns = struct {
+		result1 []*models.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SubscriptionsWithOptionsCtxReturnsOnCall(i int, result1 []*models.Subscription, result2 error) {
+	fake.SubscriptionsWithOptionsCtxStub = nil
+	if fake.subscriptionsWithOptionsCtxReturnsOnCall == nil {
+		fake.subscriptionsWithOptionsCtxReturnsOnCall = make(map[int]struct {
+			result1 []*models.Subscription
+			result2 error
+		})
+	}
+	fake.subscriptionsWithOptionsCtxReturnsOnCall[i] = struct {
+		result1 []*models.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) OrganizationSubscriptions(organizationID int32) ([]*models.Subscription, error) {
+	fake.organizationSubscriptionsMutex.Lock()
+	ret, specificReturn := fake.organizationSubscriptionsReturnsOnCall[len(fake.organizationSubscriptionsArgsForCall)]
+	fake.organizationSubscriptionsArgsForCall = append(fake.organizationSubscriptionsArgsForCall, struct {
+		organizationID int32
+	}{organizationID})
+	fake.recordInvocation("OrganizationSubscriptions", []interface{}{organizationID})
+	fake.organizationSubscriptionsMutex.Unlock()
+	if fake.OrganizationSubscriptionsStub != nil {
+		return fake.OrganizationSubscriptionsStub(organizationID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.organizationSubscriptionsReturns.result1, fake.organizationSubscriptionsReturns.result2
+}
+
+func (fake *FakeClient) OrganizationSubscriptionsCallCount() int {
+	fake.organizationSubscriptionsMutex.RLock()
+	defer fake.organizationSubscriptionsMutex.RUnlock()
+	return len(fake.organizationSubscriptionsArgsForCall)
+}
+
+func (fake *FakeClient) OrganizationSubscriptionsArgsForCall(i int) int32 {
+	fake.organizationSubscriptionsMutex.RLock()
+	defer fake.organizationSubscriptionsMutex.RUnlock()
+	return fake.organizationSubscriptionsArgsForCall[i].organizationID
+}
+
+func (fake *FakeClient) OrganizationSubscriptionsReturns(result1 []*models.Subscription, result2 error) {
+	fake.OrganizationSubscriptionsStub = nil
+	fake.organizationSubscriptionsReturns = struct {
+		result1 []*models.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) OrganizationSubscriptionsReturnsOnCall(i int, result1 []*models.Subscription, result2 error) {
+	fake.OrganizationSubscriptionsStub = nil
+	if fake.organizationSubscriptionsReturnsOnCall == nil {
+		fake.organizationSubscriptionsReturnsOnCall = make(map[int]struct {
+			result1 []*models.Subscription
+			result2 error
+		})
+	}
+	fake.organizationSubscriptionsReturnsOnCall[i] = struct {
+		result1 []*models.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) OrganizationSubscriptionsCtx(ctx context.Context, organizationID int32) ([]*models.Subscription, error) {
+	fake.organizationSubscriptionsCtxMutex.Lock()
+	ret, specificReturn := fake.organizationSubscriptionsCtxReturnsOnCall[len(fake.organizationSubscriptionsCtxArgsForCall)]
+	fake.organizationSubscriptionsCtxArgsForCall = append(fake.organizationSubscriptionsCtxArgsForCall, struct {
+		ctx            context.Context
+		organizationID int32
+	}{ctx, organizationID})
+	fake.recordInvocation("OrganizationSubscriptionsCtx", []interface{}{ctx, organizationID})
+	fake.organizationSubscriptionsCtxMutex.Unlock()
+	if fake.OrganizationSubscriptionsCtxStub != nil {
+		return fake.OrganizationSubscriptionsCtxStub(ctx, organizationID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.organizationSubscriptionsCtxReturns.result1, fake.organizationSubscriptionsCtxReturns.result2
+}
+
+func (fake *FakeClient) OrganizationSubscriptionsCtxCallCount() int {
+	fake.organizationSubscriptionsCtxMutex.RLock()
+	defer fake.organizationSubscriptionsCtxMutex.RUnlock()
+	return len(fake.organizationSubscriptionsCtxArgsForCall)
+}
+
+func (fake *FakeClient) OrganizationSubscriptionsCtxArgsForCall(i int) (context.Context, int32) {
+	fake.organizationSubscriptionsCtxMutex.RLock()
+	defer fake.organizationSubscriptionsCtxMutex.RUnlock()
+	return fake.organizationSubscriptionsCtxArgsForCall[i].ctx, fake.organizationSubscriptionsCtxArgsForCall[i].organizationID
+}
+
+func (fake *FakeClient) OrganizationSubscriptionsCtxReturns(result1 []*models.Subscription, result2 error) {
+	fake.OrganizationSubscriptionsCtxStub = nil
+	fake.organizationSubscriptionsCtxReturns = struct {
+		result1 []*models.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) OrganizationSubscriptionsCtxReturnsOnCall(i int, result1 []*models.Subscription, result2 error) {
+	fake.OrganizationSubscriptionsCtxStub = nil
+	if fake.organizationSubscriptionsCtxReturnsOnCall == nil {
+		fake.organizationSubscriptionsCtxReturnsOnCall = make(map[int]struct {
+			result1 []*models.Subscription
+			result2 error
+		})
+	}
+	fake.organizationSubscriptionsCtxReturnsOnCall[i] = struct {
+		result1 []*models.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) Subscription(organizationID, subscriptionID int32) (*models.Subscription, error) {
+	fake.subscriptionMutex.Lock()
+	ret, specificReturn := fake.subscriptionReturnsOnCall[len(fake.subscriptionArgsForCall)]
+	fake.subscriptionArgsForCall = append(fake.subscriptionArgsForCall, struct {
+		organizationID int32
+		subscriptionID int32
+	}{organizationID, subscriptionID})
+	fake.recordInvocation("Subscription", []interface{}{organizationID, subscriptionID})
+	fake.subscriptionMutex.Unlock()
+	if fake.SubscriptionStub != nil {
+		return fake.SubscriptionStub(organizationID, subscriptionID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.subscriptionReturns.result1, fake.subscriptionReturns.result2
+}
+
+func (fake *FakeClient) SubscriptionCallCount() int {
+	fake.subscriptionMutex.RLock()
+	defer fake.subscriptionMutex.RUnlock()
+	return len(fake.subscriptionArgsForCall)
+}
+
+func (fake *FakeClient) SubscriptionArgsForCall(i int) (int32, int32) {
+	fake.subscriptionMutex.RLock()
+	defer fake.subscriptionMutex.RUnlock()
+	return fake.subscriptionArgsForCall[i].organizationID, fake.subscriptionArgsForCall[i].subscriptionID
+}
+
+func (fake *FakeClient) SubscriptionReturns(result1 *models.Subscription, result2 error) {
+	fake.SubscriptionStub = nil
+	fake.subscriptionReturns = struct {
+		result1 *models.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SubscriptionReturnsOnCall(i int, result1 *models.Subscription, result2 error) {
+	fake.SubscriptionStub = nil
+	if fake.subscriptionReturnsOnCall == nil {
+		fake.subscriptionReturnsOnCall = make(map[int]struct {
+			result1 *models.Subscription
+			result2 error
+		})
+	}
+	fake.subscriptionReturnsOnCall[i] = struct {
+		result1 *models.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SubscriptionCtx(ctx context.Context, organizationID, subscriptionID int32) (*models.Subscription, error) {
+	fake.subscriptionCtxMutex.Lock()
+	ret, specificReturn := fake.subscriptionCtxReturnsOnCall[len(fake.subscriptionCtxArgsForCall)]
+	fake.subscriptionCtxArgsForCall = append(fake.subscriptionCtxArgsForCall, struct {
+		ctx            context.Context
+		organizationID int32
+		subscriptionID int32
+	}{ctx, organizationID, subscriptionID})
+	fake.recordInvocation("SubscriptionCtx", []interface{}{ctx, organizationID, subscriptionID})
+	fake.subscriptionCtxMutex.Unlock()
+	if fake.SubscriptionCtxStub != nil {
+		return fake.SubscriptionCtxStub(ctx, organizationID, subscriptionID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.subscriptionCtxReturns.result1, fake.subscriptionCtxReturns.result2
+}
+
+func (fake *FakeClient) SubscriptionCtxCallCount() int {
+	fake.subscriptionCtxMutex.RLock()
+	defer fake.subscriptionCtxMutex.RUnlock()
+	return len(fake.subscriptionCtxArgsForCall)
+}
+
+func (fake *FakeClient) SubscriptionCtxArgsForCall(i int) (context.Context, int32, int32) {
+	fake.subscriptionCtxMutex.RLock()
+	defer fake.subscriptionCtxMutex.RUnlock()
+	return fake.subscriptionCtxArgsForCall[i].ctx, fake.subscriptionCtxArgsForCall[i].organizationID, fake.subscriptionCtxArgsForCall[i].subscriptionID
+}
+
+func (fake *FakeClient) SubscriptionCtxReturns(result1 *models.Subscription, result2 error) {
+	fake.SubscriptionCtxStub = nil
+	fake.subscriptionCtxReturns = struct {
+		result1 *models.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SubscriptionCtxReturnsOnCall(i int, result1 *models.Subscription, result2 error) {
+	fake.SubscriptionCtxStub = nil
+	if fake.subscriptionCtxReturnsOnCall == nil {
+		fake.subscriptionCtxReturnsOnCall = make(map[int]struct {
+			result1 *models.Subscription
+			result2 error
+		})
+	}
+	fake.subscriptionCtxReturnsOnCall[i] = struct {
+		result1 *models.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UpdateSubscription(subscription *models.Subscription) (a *models.Subscription, err error) {
+	fake.updateSubscriptionMutex.Lock()
+	ret, specificReturn := fake.updateSubscriptionReturnsOnCall[len(fake.updateSubscriptionArgsForCall)]
+	fake.updateSubscriptionArgsForCall = append(fake.updateSubscriptionArgsForCall, struct {
+		subscription *models.Subscription
+	}{subscription})
+	fake.recordInvocation("UpdateSubscription", []interface{}{subscription})
+	fake.updateSubscriptionMutex.Unlock()
+	if fake.UpdateSubscriptionStub != nil {
+		return fake.UpdateSubscriptionStub(subscription)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateSubscriptionReturns.result1, fake.updateSubscriptionReturns.result2
+}
+
+func (fake *FakeClient) UpdateSubscriptionCallCount() int {
+	fake.updateSubscriptionMutex.RLock()
+	defer fake.updateSubscriptionMutex.RUnlock()
+	return len(fake.updateSubscriptionArgsForCall)
+}
+
+func (fake *FakeClient) UpdateSubscriptionArgsForCall(i int) *models.Subscription {
+	fake.updateSubscriptionMutex.RLock()
+	defer fake.updateSubscriptionMutex.RUnlock()
+	return fake.updateSubscriptionArgsForCall[i].subscription
+}
+
+func (fake *FakeClient) UpdateSubscriptionReturns(result1 *models.Subscription, result2 error) {
+	fake.UpdateSubscriptionStub = nil
+	fake.updateSubscriptionReturns = struct {
+		result1 *models.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UpdateSubscriptionReturnsOnCall(i int, result1 *models.Subscription, result2 error) {
+	fake.UpdateSubscriptionStub = nil
+	if fake.updateSubscriptionReturnsOnCall == nil {
+		fake.updateSubscriptionReturnsOnCall = make(map[int]struct {
+			result1 *models.Subscription
+			result2 error
+		})
+	}
+	fake.updateSubscriptionReturnsOnCall[i] = struct {
+		result1 *models.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UpdateSubscriptionCtx(ctx context.Context, subscription *models.Subscription) (a *models.Subscription, err error) {
+	fake.updateSubscriptionCtxMutex.Lock()
+	ret, specificReturn := fake.updateSubscriptionCtxReturnsOnCall[len(fake.updateSubscriptionCtxArgsForCall)]
+	fake.updateSubscriptionCtxArgsForCall = append(fake.updateSubscriptionCtxArgsForCall, struct {
+		ctx          context.Context
+		subscription *models.Subscription
+	}{ctx, subscription})
+	fake.recordInvocation("UpdateSubscriptionCtx", []interface{}{ctx, subscription})
+	fake.updateSubscriptionCtxMutex.Unlock()
+	if fake.UpdateSubscriptionCtxStub != nil {
+		return fake.UpdateSubscriptionCtxStub(ctx, subscription)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateSubscriptionCtxReturns.result1, fake.updateSubscriptionCtxReturns.result2
+}
+
+func (fake *FakeClient) UpdateSubscriptionCtxCallCount() int {
+	fake.updateSubscriptionCtxMutex.RLock()
+	defer fake.updateSubscriptionCtxMutex.RUnlock()
+	return len(fake.updateSubscriptionCtxArgsForCall)
+}
+
+func (fake *FakeClient) UpdateSubscriptionCtxArgsForCall(i int) (context.Context, *models.Subscription) {
+	fake.updateSubscriptionCtxMutex.RLock()
+	defer fake.updateSubscriptionCtxMutex.RUnlock()
+	return fake.updateSubscriptionCtxArgsForCall[i].ctx, fake.updateSubscriptionCtxArgsForCall[i].subscription
+}
+
+func (fake *FakeClient) UpdateSubscriptionCtxReturns(result1 *models.Subscription, result2 error) {
+	fake.UpdateSubscriptionCtxStub = nil
+	fake.updateSubscriptionCtxReturns = struct {
+		result1 *models.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UpdateSubscriptionCtxReturnsOnCall(i int, result1 *models.Subscription, result2 error) {
+	fake.UpdateSubscriptionCtxStub = nil
+	if fake.updateSubscriptionCtxReturnsOnCall == nil {
+		fake.updateSubscriptionCtxReturnsOnCall = make(map[int]struct {
+			result1 *models.Subscription
+			result2 error
+		})
+	}
+	fake.updateSubscriptionCtxReturnsOnCall[i] = struct {
+		result1 *models.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreateSubscription(organizationID, planID int32, paymentMethod string) (*models.Subscription, error) {
+	fake.createSubscriptionMutex.Lock()
+	ret, specificReturn := fake.createSubscriptionReturnsOnCall[len(fake.createSubscriptionArgsForCall)]
+	fake.createSubscriptionArgsForCall = append(fake.createSubscriptionArgsForCall, struct {
+		organizationID int32
+		planID         int32
+		paymentMethod  string
+	}{organizationID, planID, paymentMethod})
+	fake.recordInvocation("CreateSubscription", []interface{}{organizationID, planID, paymentMethod})
+	fake.createSubscriptionMutex.Unlock()
+	if fake.CreateSubscriptionStub != nil {
+		return fake.CreateSubscriptionStub(organizationID, planID, paymentMethod)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.createSubscriptionReturns.result1, fake.createSubscriptionReturns.result2
+}
+
+func (fake *FakeClient) CreateSubscriptionCallCount() int {
+	fake.createSubscriptionMutex.RLock()
+	defer fake.createSubscriptionMutex.RUnlock()
+	return len(fake.createSubscriptionArgsForCall)
+}
+
+func (fake *FakeClient) CreateSubscriptionArgsForCall(i int) (int32, int32, string) {
+	fake.createSubscriptionMutex.RLock()
+	defer fake.createSubscriptionMutex.RUnlock()
+	return fake.createSubscriptionArgsForCall[i].organizationID, fake.createSubscriptionArgsForCall[i].planID, fake.createSubscriptionArgsForCall[i].paymentMethod
+}
+
+func (fake *FakeClient) CreateSubscriptionReturns(result1 *models.Subscription, result2 error) {
+	fake.CreateSubscriptionStub = nil
+	fake.createSubscriptionReturns = struct {
+		result1 *models.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreateSubscriptionReturnsOnCall(i int, result1 *models.Subscription, result2 error) {
+	fake.CreateSubscriptionStub = nil
+	if fake.createSubscriptionReturnsOnCall == nil {
+		fake.createSubscriptionReturnsOnCall = make(map[int]struct {
+			result1 *models.Subscription
+			result2 error
+		})
+	}
+	fake.createSubscriptionReturnsOnCall[i] = struct {
+		result1 *models.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreateSubscriptionCtx(ctx context.Context, organizationID, planID int32, paymentMethod string) (*models.Subscription, error) {
+	fake.createSubscriptionCtxMutex.Lock()
+	ret, specificReturn := fake.createSubscriptionCtxReturnsOnCall[len(fake.createSubscriptionCtxArgsForCall)]
+	fake.createSubscriptionCtxArgsForCall = append(fake.createSubscriptionCtxArgsForCall, struct {
+		ctx            context.Context
+		organizationID int32
+		planID         int32
+		paymentMethod  string
+	}{ctx, organizationID, planID, paymentMethod})
+	fake.recordInvocation("CreateSubscriptionCtx", []interface{}{ctx, organizationID, planID, paymentMethod})
+	fake.createSubscriptionCtxMutex.Unlock()
+	if fake.CreateSubscriptionCtxStub != nil {
+		return fake.CreateSubscriptionCtxStub(ctx, organizationID, planID, paymentMethod)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.createSubscriptionCtxReturns.result1, fake.createSubscriptionCtxReturns.result2
+}
+
+func (fake *FakeClient) CreateSubscriptionCtxCallCount() int {
+	fake.createSubscriptionCtxMutex.RLock()
+	defer fake.createSubscriptionCtxMutex.RUnlock()
+	return len(fake.createSubscriptionCtxArgsForCall)
+}
+
+func (fake *FakeClient) CreateSubscriptionCtxArgsForCall(i int) (context.Context, int32, int32, string) {
+	fake.createSubscriptionCtxMutex.RLock()
+	defer fake.createSubscriptionCtxMutex.RUnlock()
+	return fake.createSubscriptionCtxArgsForCall[i].ctx, fake.createSubscriptionCtxArgsForCall[i].organizationID, fake.createSubscriptionCtxArgsForCall[i].planID, fake.createSubscriptionCtxArgsForCall[i].paymentMethod
+}
+
+func (fake *FakeClient) CreateSubscriptionCtxReturns(result1 *models.Subscription, result2 error) {
+	fake.CreateSubscriptionCtxStub = nil
+	fake.createSubscriptionCtxReturns = struct {
+		result1 *models.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreateSubscriptionCtxReturnsOnCall(i int, result1 *models.Subscription, result2 error) {
+	fake.CreateSubscriptionCtxStub = nil
+	if fake.createSubscriptionCtxReturnsOnCall == nil {
+		fake.createSubscriptionCtxReturnsOnCall = make(map[int]struct {
+			result1 *models.Subscription
+			result2 error
+		})
+	}
+	fake.createSubscriptionCtxReturnsOnCall[i] = struct {
+		result1 *models.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CancelSubscription(organizationID, subscriptionID int32, canceledBy string) (*models.Subscription, error) {
+	fake.cancelSubscriptionMutex.Lock()
+	ret, specificReturn := fake.cancelSubscriptionReturnsOnCall[len(fake.cancelSubscriptionArgsForCall)]
+	fake.cancelSubscriptionArgsForCall = append(fake.cancelSubscriptionArgsForCall, struct {
+		organizationID int32
+		subscriptionID int32
+		canceledBy     string
+	}{organizationID, subscriptionID, canceledBy})
+	fake.recordInvocation("CancelSubscription", []interface{}{organizationID, subscriptionID, canceledBy})
+	fake.cancelSubscriptionMutex.Unlock()
+	if fake.CancelSubscriptionStub != nil {
+		return fake.CancelSubscriptionStub(organizationID, subscriptionID, canceledBy)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.cancelSubscriptionReturns.result1, fake.cancelSubscriptionReturns.result2
+}
+
+func (fake *FakeClient) CancelSubscriptionCallCount() int {
+	fake.cancelSubscriptionMutex.RLock()
+	defer fake.cancelSubscriptionMutex.RUnlock()
+	return len(fake.cancelSubscriptionArgsForCall)
+}
+
+func (fake *FakeClient) CancelSubscriptionArgsForCall(i int) (int32, int32, string) {
+	fake.cancelSubscriptionMutex.RLock()
+	defer fake.cancelSubscriptionMutex.RUnlock()
+	return fake.cancelSubscriptionArgsForCall[i].organizationID, fake.cancelSubscriptionArgsForCall[i].subscriptionID, fake.cancelSubscriptionArgsForCall[i].canceledBy
+}
+
+func (fake *FakeClient) CancelSubscriptionReturns(result1 *models.Subscription, result2 error) {
+	fake.CancelSubscriptionStub = nil
+	fake.cancelSubscriptionReturns = struct {
+		result1 *models.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CancelSubscriptionReturnsOnCall(i int, result1 *models.Subscription, result2 error) {
+	fake.CancelSubscriptionStub = nil
+	if fake.cancelSubscriptionReturnsOnCall == nil {
+		fake.cancelSubscriptionReturnsOnCall = make(map[int]struct {
+			result1 *models.Subscription
+			result2 error
+		})
+	}
+	fake.cancelSubscriptionReturnsOnCall[i] = struct {
+		result1 *models.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CancelSubscriptionCtx(ctx context.Context, organizationID, subscriptionID int32, canceledBy string) (*models.Subscription, error) {
+	fake.cancelSubscriptionCtxMutex.Lock()
+	ret, specificReturn := fake.cancelSubscriptionCtxReturnsOnCall[len(fake.cancelSubscriptionCtxArgsForCall)]
+	fake.cancelSubscriptionCtxArgsForCall = append(fake.cancelSubscriptionCtxArgsForCall, struct {
+		ctx            context.Context
+		organizationID int32
+		subscriptionID int32
+		canceledBy     string
+	}{ctx, organizationID, subscriptionID, canceledBy})
+	fake.recordInvocation("CancelSubscriptionCtx", []interface{}{ctx, organizationID, subscriptionID, canceledBy})
+	fake.cancelSubscriptionCtxMutex.Unlock()
+	if fake.CancelSubscriptionCtxStub != nil {
+		return fake.CancelSubscriptionCtxStub(ctx, organizationID, subscriptionID, canceledBy)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.cancelSubscriptionCtxReturns.result1, fake.cancelSubscriptionCtxReturns.result2
+}
+
+func (fake *FakeClient) CancelSubscriptionCtxCallCount() int {
+	fake.cancelSubscriptionCtxMutex.RLock()
+	defer fake.cancelSubscriptionCtxMutex.RUnlock()
+	return len(fake.cancelSubscriptionCtxArgsForCall)
 }
 
-func (fake *FakeClient) OrganizationReturns(result1 *models.Organization, result2 error) {
-	fake.OrganizationStub = nil
-	fake.organizationReturns = struct {
-		result1 *models.Organization
+func (fake *FakeClient) CancelSubscriptionCtxArgsForCall(i int) (context.Context, int32, int32, string) {
+	fake.cancelSubscriptionCtxMutex.RLock()
+	defer fake.cancelSubscriptionCtxMutex.RUnlock()
+	return fake.cancelSubscriptionCtxArgsForCall[i].ctx, fake.cancelSubscriptionCtxArgsForCall[i].organizationID, fake.cancelSubscriptionCtxArgsForCall[i].subscriptionID, fake.cancelSubscriptionCtxArgsForCall[i].canceledBy
+}
+
+func (fake *FakeClient) CancelSubscriptionCtxReturns(result1 *models.Subscription, result2 error) {
+	fake.CancelSubscriptionCtxStub = nil
+	fake.cancelSubscriptionCtxReturns = struct {
+		result1 *models.Subscription
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) OrganizationReturnsOnCall(i int, result1 *models.Organization, result2 error) {
-	fake.OrganizationStub = nil
-	if fake.organizationReturnsOnCall == nil {
-		fake.organizationReturnsOnCall = make(map[int]struct {
-			result1 *models.Organization
+func (fake *FakeClient) CancelSubscriptionCtxReturnsOnCall(i int, result1 *models.Subscription, result2 error) {
+	fake.CancelSubscriptionCtxStub = nil
+	if fake.cancelSubscriptionCtxReturnsOnCall == nil {
+		fake.cancelSubscriptionCtxReturnsOnCall = make(map[int]struct {
+			result1 *models.Subscription
 			result2 error
 		})
 	}
-	fake.organizationReturnsOnCall[i] = struct {
-		result1 *models.Organization
+	fake.cancelSubscriptionCtxReturnsOnCall[i] = struct {
+		result1 *models.Subscription
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) Subscriptions(limit *int32) ([]*models.Subscription, error) {
-	fake.subscriptionsMutex.Lock()
-	ret, specificReturn := fake.subscriptionsReturnsOnCall[len(fake.subscriptionsArgsForCall)]
-	fake.subscriptionsArgsForCall = append(fake.subscriptionsArgsForCall, struct {
-		limit *int32
-	}{limit})
-	fake.recordInvocation("Subscriptions", []interface{}{limit})
-	fake.subscriptionsMutex.Unlock()
-	if fake.SubscriptionsStub != nil {
-		return fake.SubscriptionsStub(limit)
+func (fake *FakeClient) ReactivateSubscription(organizationID, subscriptionID int32, reactivatedBy string) (*models.Subscription, error) {
+	fake.reactivateSubscriptionMutex.Lock()
+	ret, specificReturn := fake.reactivateSubscriptionReturnsOnCall[len(fake.reactivateSubscriptionArgsForCall)]
+	fake.reactivateSubscriptionArgsForCall = append(fake.reactivateSubscriptionArgsForCall, struct {
+		organizationID int32
+		subscriptionID int32
+		reactivatedBy  string
+	}{organizationID, subscriptionID, reactivatedBy})
+	fake.recordInvocation("ReactivateSubscription", []interface{}{organizationID, subscriptionID, reactivatedBy})
+	fake.reactivateSubscriptionMutex.Unlock()
+	if fake.ReactivateSubscriptionStub != nil {
+		return fake.ReactivateSubscriptionStub(organizationID, subscriptionID, reactivatedBy)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	return fake.subscriptionsReturns.result1, fake.subscriptionsReturns.result2
+	return fake.reactivateSubscriptionReturns.result1, fake.reactivateSubscriptionReturns.result2
 }
 
-func (fake *FakeClient) SubscriptionsCallCount() int {
-	fake.subscriptionsMutex.RLock()
-	defer fake.subscriptionsMutex.RUnlock()
-	return len(fake.subscriptionsArgsForCall)
+func (fake *FakeClient) ReactivateSubscriptionCallCount() int {
+	fake.reactivateSubscriptionMutex.RLock()
+	defer fake.reactivateSubscriptionMutex.RUnlock()
+	return len(fake.reactivateSubscriptionArgsForCall)
 }
 
-func (fake *FakeClient) SubscriptionsArgsForCall(i int) *int32 {
-	fake.subscriptionsMutex.RLock()
-	defer fake.subscriptionsMutex.RUnlock()
-	return fake.subscriptionsArgsForCall[i].limit
+func (fake *FakeClient) ReactivateSubscriptionArgsForCall(i int) (int32, int32, string) {
+	fake.reactivateSubscriptionMutex.RLock()
+	defer fake.reactivateSubscriptionMutex.RUnlock()
+	return fake.reactivateSubscriptionArgsForCall[i].organizationID, fake.reactivateSubscriptionArgsForCall[i].subscriptionID, fake.reactivateSubscriptionArgsForCall[i].reactivatedBy
 }
 
-func (fake *FakeClient) SubscriptionsReturns(result1 []*models.Subscription, result2 error) {
-	fake.SubscriptionsStub = nil
-	fake.subscriptionsReturns = struct {
-		result1 []*models.Subscription
+func (fake *FakeClient) ReactivateSubscriptionReturns(result1 *models.Subscription, result2 error) {
+	fake.ReactivateSubscriptionStub = nil
+	fake.reactivateSubscriptionReturns = struct {
+		result1 *models.Subscription
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) SubscriptionsReturnsOnCall(i int, result1 []*models.Subscription, result2 error) {
-	fake.SubscriptionsStub = nil
-	if fake.subscriptionsReturnsOnCall == nil {
-		fake.subscriptionsReturnsOnCall = make(map[int]struct {
-			result1 []*models.Subscription
+func (fake *FakeClient) ReactivateSubscriptionReturnsOnCall(i int, result1 *models.Subscription, result2 error) {
+	fake.ReactivateSubscriptionStub = nil
+	if fake.reactivateSubscriptionReturnsOnCall == nil {
+		fake.reactivateSubscriptionReturnsOnCall = make(map[int]struct {
+			result1 *models.Subscription
 			result2 error
 		})
 	}
-	fake.subscriptionsReturnsOnCall[i] = struct {
-		result1 []*models.Subscription
+	fake.reactivateSubscriptionReturnsOnCall[i] = struct {
+		result1 *models.Subscription
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) UpdateSubscription(subscription *models.Subscription) (a *models.Subscription, err error) {
-	fake.updateSubscriptionMutex.Lock()
-	ret, specificReturn := fake.updateSubscriptionReturnsOnCall[len(fake.updateSubscriptionArgsForCall)]
-	fake.updateSubscriptionArgsForCall = append(fake.updateSubscriptionArgsForCall, struct {
-		subscription *models.Subscription
-	}{subscription})
-	fake.recordInvocation("UpdateSubscription", []interface{}{subscription})
-	fake.updateSubscriptionMutex.Unlock()
-	if fake.UpdateSubscriptionStub != nil {
-		return fake.UpdateSubscriptionStub(subscription)
+func (fake *FakeClient) ReactivateSubscriptionCtx(ctx context.Context, organizationID, subscriptionID int32, reactivatedBy string) (*models.Subscription, error) {
+	fake.reactivateSubscriptionCtxMutex.Lock()
+	ret, specificReturn := fake.reactivateSubscriptionCtxReturnsOnCall[len(fake.reactivateSubscriptionCtxArgsForCall)]
+	fake.reactivateSubscriptionCtxArgsForCall = append(fake.reactivateSubscriptionCtxArgsForCall, struct {
+		ctx            context.Context
+		organizationID int32
+		subscriptionID int32
+		reactivatedBy  string
+	}{ctx, organizationID, subscriptionID, reactivatedBy})
+	fake.recordInvocation("ReactivateSubscriptionCtx", []interface{}{ctx, organizationID, subscriptionID, reactivatedBy})
+	fake.reactivateSubscriptionCtxMutex.Unlock()
+	if fake.ReactivateSubscriptionCtxStub != nil {
+		return fake.ReactivateSubscriptionCtxStub(ctx, organizationID, subscriptionID, reactivatedBy)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	return fake.updateSubscriptionReturns.result1, fake.updateSubscriptionReturns.result2
+	return fake.reactivateSubscriptionCtxReturns.result1, fake.reactivateSubscriptionCtxReturns.result2
 }
 
-func (fake *FakeClient) UpdateSubscriptionCallCount() int {
-	fake.updateSubscriptionMutex.RLock()
-	defer fake.updateSubscriptionMutex.RUnlock()
-	return len(fake.updateSubscriptionArgsForCall)
+func (fake *FakeClient) ReactivateSubscriptionCtxCallCount() int {
+	fake.reactivateSubscriptionCtxMutex.RLock()
+	defer fake.reactivateSubscriptionCtxMutex.RUnlock()
+	return len(fake.reactivateSubscriptionCtxArgsForCall)
 }
 
-func (fake *FakeClient) UpdateSubscriptionArgsForCall(i int) *models.Subscription {
-	fake.updateSubscriptionMutex.RLock()
-	defer fake.updateSubscriptionMutex.RUnlock()
-	return fake.updateSubscriptionArgsForCall[i].subscription
+func (fake *FakeClient) ReactivateSubscriptionCtxArgsForCall(i int) (context.Context, int32, int32, string) {
+	fake.reactivateSubscriptionCtxMutex.RLock()
+	defer fake.reactivateSubscriptionCtxMutex.RUnlock()
+	return fake.reactivateSubscriptionCtxArgsForCall[i].ctx, fake.reactivateSubscriptionCtxArgsForCall[i].organizationID, fake.reactivateSubscriptionCtxArgsForCall[i].subscriptionID, fake.reactivateSubscriptionCtxArgsForCall[i].reactivatedBy
 }
 
-func (fake *FakeClient) UpdateSubscriptionReturns(result1 *models.Subscription, result2 error) {
-	fake.UpdateSubscriptionStub = nil
-	fake.updateSubscriptionReturns = struct {
+func (fake *FakeClient) ReactivateSubscriptionCtxReturns(result1 *models.Subscription, result2 error) {
+	fake.ReactivateSubscriptionCtxStub = nil
+	fake.reactivateSubscriptionCtxReturns = struct {
 		result1 *models.Subscription
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) UpdateSubscriptionReturnsOnCall(i int, result1 *models.Subscription, result2 error) {
-	fake.UpdateSubscriptionStub = nil
-	if fake.updateSubscriptionReturnsOnCall == nil {
-		fake.updateSubscriptionReturnsOnCall = make(map[int]struct {
+func (fake *FakeClient) ReactivateSubscriptionCtxReturnsOnCall(i int, result1 *models.Subscription, result2 error) {
+	fake.ReactivateSubscriptionCtxStub = nil
+	if fake.reactivateSubscriptionCtxReturnsOnCall == nil {
+		fake.reactivateSubscriptionCtxReturnsOnCall = make(map[int]struct {
 			result1 *models.Subscription
 			result2 error
 		})
 	}
-	fake.updateSubscriptionReturnsOnCall[i] = struct {
+	fake.reactivateSubscriptionCtxReturnsOnCall[i] = struct {
 		result1 *models.Subscription
 		result2 error
 	}{result1, result2}
@@ -336,6 +2040,161 @@ func (fake *FakeClient) PlanReturnsOnCall(i int, result1 *models.Plan, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeClient) PlanCtx(ctx context.Context, planID int32) (org *models.Plan, err error) {
+	fake.planCtxMutex.Lock()
+	ret, specificReturn := fake.planCtxReturnsOnCall[len(fake.planCtxArgsForCall)]
+	fake.planCtxArgsForCall = append(fake.planCtxArgsForCall, struct {
+		ctx    context.Context
+		planID int32
+	}{ctx, planID})
+	fake.recordInvocation("PlanCtx", []interface{}{ctx, planID})
+	fake.planCtxMutex.Unlock()
+	if fake.PlanCtxStub != nil {
+		return fake.PlanCtxStub(ctx, planID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.planCtxReturns.result1, fake.planCtxReturns.result2
+}
+
+func (fake *FakeClient) PlanCtxCallCount() int {
+	fake.planCtxMutex.RLock()
+	defer fake.planCtxMutex.RUnlock()
+	return len(fake.planCtxArgsForCall)
+}
+
+func (fake *FakeClient) PlanCtxArgsForCall(i int) (context.Context, int32) {
+	fake.planCtxMutex.RLock()
+	defer fake.planCtxMutex.RUnlock()
+	return fake.planCtxArgsForCall[i].ctx, fake.planCtxArgsForCall[i].planID
+}
+
+func (fake *FakeClient) PlanCtxReturns(result1 *models.Plan, result2 error) {
+	fake.PlanCtxStub = nil
+	fake.planCtxReturns = struct {
+		result1 *models.Plan
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) PlanCtxReturnsOnCall(i int, result1 *models.Plan, result2 error) {
+	fake.PlanCtxStub = nil
+	if fake.planCtxReturnsOnCall == nil {
+		fake.planCtxReturnsOnCall = make(map[int]struct {
+			result1 *models.Plan
+			result2 error
+		})
+	}
+	fake.planCtxReturnsOnCall[i] = struct {
+		result1 *models.Plan
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) Plans(options organization.ListPlansOptions) ([]*models.Plan, error) {
+	fake.plansMutex.Lock()
+	ret, specificReturn := fake.plansReturnsOnCall[len(fake.plansArgsForCall)]
+	fake.plansArgsForCall = append(fake.plansArgsForCall, struct {
+		options organization.ListPlansOptions
+	}{options})
+	fake.recordInvocation("Plans", []interface{}{options})
+	fake.plansMutex.Unlock()
+	if fake.PlansStub != nil {
+		return fake.PlansStub(options)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.plansReturns.result1, fake.plansReturns.result2
+}
+
+func (fake *FakeClient) PlansCallCount() int {
+	fake.plansMutex.RLock()
+	defer fake.plansMutex.RUnlock()
+	return len(fake.plansArgsForCall)
+}
+
+func (fake *FakeClient) PlansArgsForCall(i int) organization.ListPlansOptions {
+	fake.plansMutex.RLock()
+	defer fake.plansMutex.RUnlock()
+	return fake.plansArgsForCall[i].options
+}
+
+func (fake *FakeClient) PlansReturns(result1 []*models.Plan, result2 error) {
+	fake.PlansStub = nil
+	fake.plansReturns = struct {
+		result1 []*models.Plan
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) PlansReturnsOnCall(i int, result1 []*models.Plan, result2 error) {
+	fake.PlansStub = nil
+	if fake.plansReturnsOnCall == nil {
+		fake.plansReturnsOnCall = make(map[int]struct {
+			result1 []*models.Plan
+			result2 error
+		})
+	}
+	fake.plansReturnsOnCall[i] = struct {
+		result1 []*models.Plan
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) PlansCtx(ctx context.Context, options organization.ListPlansOptions) ([]*models.Plan, error) {
+	fake.plansCtxMutex.Lock()
+	ret, specificReturn := fake.plansCtxReturnsOnCall[len(fake.plansCtxArgsForCall)]
+	fake.plansCtxArgsForCall = append(fake.plansCtxArgsForCall, struct {
+		ctx     context.Context
+		options organization.ListPlansOptions
+	}{ctx, options})
+	fake.recordInvocation("PlansCtx", []interface{}{ctx, options})
+	fake.plansCtxMutex.Unlock()
+	if fake.PlansCtxStub != nil {
+		return fake.PlansCtxStub(ctx, options)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.plansCtxReturns.result1, fake.plansCtxReturns.result2
+}
+
+func (fake *FakeClient) PlansCtxCallCount() int {
+	fake.plansCtxMutex.RLock()
+	defer fake.plansCtxMutex.RUnlock()
+	return len(fake.plansCtxArgsForCall)
+}
+
+func (fake *FakeClient) PlansCtxArgsForCall(i int) (context.Context, organization.ListPlansOptions) {
+	fake.plansCtxMutex.RLock()
+	defer fake.plansCtxMutex.RUnlock()
+	return fake.plansCtxArgsForCall[i].ctx, fake.plansCtxArgsForCall[i].options
+}
+
+func (fake *FakeClient) PlansCtxReturns(result1 []*models.Plan, result2 error) {
+	fake.PlansCtxStub = nil
+	fake.plansCtxReturns = struct {
+		result1 []*models.Plan
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) PlansCtxReturnsOnCall(i int, result1 []*models.Plan, result2 error) {
+	fake.PlansCtxStub = nil
+	if fake.plansCtxReturnsOnCall == nil {
+		fake.plansCtxReturnsOnCall = make(map[int]struct {
+			result1 []*models.Plan
+			result2 error
+		})
+	}
+	fake.plansCtxReturnsOnCall[i] = struct {
+		result1 []*models.Plan
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) OrganizationUsers(organizationID int32) (users []*models.User, err error) {
 	fake.organizationUsersMutex.Lock()
 	ret, specificReturn := fake.organizationUsersReturnsOnCall[len(fake.organizationUsersArgsForCall)]
@@ -387,21 +2246,347 @@ func (fake *FakeClient) OrganizationUsersReturnsOnCall(i int, result1 []*models.
 	}{result1, result2}
 }
 
+func (fake *FakeClient) OrganizationUsersCtx(ctx context.Context, organizationID int32) (users []*models.User, err error) {
+	fake.organizationUsersCtxMutex.Lock()
+	ret, specificReturn := fake.organizationUsersCtxReturnsOnCall[len(fake.organizationUsersCtxArgsForCall)]
+	fake.organizationUsersCtxArgsForCall = append(fake.organizationUsersCtxArgsForCall, struct {
+		ctx            context.Context
+		organizationID int32
+	}{ctx, organizationID})
+	fake.recordInvocation("OrganizationUsersCtx", []interface{}{ctx, organizationID})
+	fake.organizationUsersCtxMutex.Unlock()
+	if fake.OrganizationUsersCtxStub != nil {
+		return fake.OrganizationUsersCtxStub(ctx, organizationID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.organizationUsersCtxReturns.result1, fake.organizationUsersCtxReturns.result2
+}
+
+func (fake *FakeClient) OrganizationUsersCtxCallCount() int {
+	fake.organizationUsersCtxMutex.RLock()
+	defer fake.organizationUsersCtxMutex.RUnlock()
+	return len(fake.organizationUsersCtxArgsForCall)
+}
+
+func (fake *FakeClient) OrganizationUsersCtxArgsForCall(i int) (context.Context, int32) {
+	fake.organizationUsersCtxMutex.RLock()
+	defer fake.organizationUsersCtxMutex.RUnlock()
+	return fake.organizationUsersCtxArgsForCall[i].ctx, fake.organizationUsersCtxArgsForCall[i].organizationID
+}
+
+func (fake *FakeClient) OrganizationUsersCtxReturns(result1 []*models.User, result2 error) {
+	fake.OrganizationUsersCtxStub = nil
+	fake.organizationUsersCtxReturns = struct {
+		result1 []*models.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) OrganizationUsersCtxReturnsOnCall(i int, result1 []*models.User, result2 error) {
+	fake.OrganizationUsersCtxStub = nil
+	if fake.organizationUsersCtxReturnsOnCall == nil {
+		fake.organizationUsersCtxReturnsOnCall = make(map[int]struct {
+			result1 []*models.User
+			result2 error
+		})
+	}
+	fake.organizationUsersCtxReturnsOnCall[i] = struct {
+		result1 []*models.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreateOrganizationUser(organizationID int32, user *models.UserPost) (*models.User, error) {
+	fake.createOrganizationUserMutex.Lock()
+	ret, specificReturn := fake.createOrganizationUserReturnsOnCall[len(fake.createOrganizationUserArgsForCall)]
+	fake.createOrganizationUserArgsForCall = append(fake.createOrganizationUserArgsForCall, struct {
+		organizationID int32
+		user           *models.UserPost
+	}{organizationID, user})
+	fake.recordInvocation("CreateOrganizationUser", []interface{}{organizationID, user})
+	fake.createOrganizationUserMutex.Unlock()
+	if fake.CreateOrganizationUserStub != nil {
+		return fake.CreateOrganizationUserStub(organizationID, user)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.createOrganizationUserReturns.result1, fake.createOrganizationUserReturns.result2
+}
+
+func (fake *FakeClient) CreateOrganizationUserCallCount() int {
+	fake.createOrganizationUserMutex.RLock()
+	defer fake.createOrganizationUserMutex.RUnlock()
+	return len(fake.createOrganizationUserArgsForCall)
+}
+
+func (fake *FakeClient) CreateOrganizationUserArgsForCall(i int) (int32, *models.UserPost) {
+	fake.createOrganizationUserMutex.RLock()
+	defer fake.createOrganizationUserMutex.RUnlock()
+	return fake.createOrganizationUserArgsForCall[i].organizationID, fake.createOrganizationUserArgsForCall[i].user
+}
+
+func (fake *FakeClient) CreateOrganizationUserReturns(result1 *models.User, result2 error) {
+	fake.CreateOrganizationUserStub = nil
+	fake.createOrganizationUserReturns = struct {
+		result1 *models.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreateOrganizationUserReturnsOnCall(i int, result1 *models.User, result2 error) {
+	fake.CreateOrganizationUserStub = nil
+	if fake.createOrganizationUserReturnsOnCall == nil {
+		fake.createOrganizationUserReturnsOnCall = make(map[int]struct {
+			result1 *models.User
+			result2 error
+		})
+	}
+	fake.createOrganizationUserReturnsOnCall[i] = struct {
+		result1 *models.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreateOrganizationUserCtx(ctx context.Context, organizationID int32, user *models.UserPost) (*models.User, error) {
+	fake.createOrganizationUserCtxMutex.Lock()
+	ret, specificReturn := fake.createOrganizationUserCtxReturnsOnCall[len(fake.createOrganizationUserCtxArgsForCall)]
+	fake.createOrganizationUserCtxArgsForCall = append(fake.createOrganizationUserCtxArgsForCall, struct {
+		ctx            context.Context
+		organizationID int32
+		user           *models.UserPost
+	}{ctx, organizationID, user})
+	fake.recordInvocation("CreateOrganizationUserCtx", []interface{}{ctx, organizationID, user})
+	fake.createOrganizationUserCtxMutex.Unlock()
+	if fake.CreateOrganizationUserCtxStub != nil {
+		return fake.CreateOrganizationUserCtxStub(ctx, organizationID, user)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.createOrganizationUserCtxReturns.result1, fake.createOrganizationUserCtxReturns.result2
+}
+
+func (fake *FakeClient) CreateOrganizationUserCtxCallCount() int {
+	fake.createOrganizationUserCtxMutex.RLock()
+	defer fake.createOrganizationUserCtxMutex.RUnlock()
+	return len(fake.createOrganizationUserCtxArgsForCall)
+}
+
+func (fake *FakeClient) CreateOrganizationUserCtxArgsForCall(i int) (context.Context, int32, *models.UserPost) {
+	fake.createOrganizationUserCtxMutex.RLock()
+	defer fake.createOrganizationUserCtxMutex.RUnlock()
+	return fake.createOrganizationUserCtxArgsForCall[i].ctx, fake.createOrganizationUserCtxArgsForCall[i].organizationID, fake.createOrganizationUserCtxArgsForCall[i].user
+}
+
+func (fake *FakeClient) CreateOrganizationUserCtxReturns(result1 *models.User, result2 error) {
+	fake.CreateOrganizationUserCtxStub = nil
+	fake.createOrganizationUserCtxReturns = struct {
+		result1 *models.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreateOrganizationUserCtxReturnsOnCall(i int, result1 *models.User, result2 error) {
+	fake.CreateOrganizationUserCtxStub = nil
+	if fake.createOrganizationUserCtxReturnsOnCall == nil {
+		fake.createOrganizationUserCtxReturnsOnCall = make(map[int]struct {
+			result1 *models.User
+			result2 error
+		})
+	}
+	fake.createOrganizationUserCtxReturnsOnCall[i] = struct {
+		result1 *models.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ImpersonationURL(userID string, options organization.ImpersonationOptions) (*models.ImpersonateURL, error) {
+	fake.impersonationURLMutex.Lock()
+	ret, specificReturn := fake.impersonationURLReturnsOnCall[len(fake.impersonationURLArgsForCall)]
+	fake.impersonationURLArgsForCall = append(fake.impersonationURLArgsForCall, struct {
+		userID  string
+		options organization.ImpersonationOptions
+	}{userID, options})
+	fake.recordInvocation("ImpersonationURL", []interface{}{userID, options})
+	fake.impersonationURLMutex.Unlock()
+	if fake.ImpersonationURLStub != nil {
+		return fake.ImpersonationURLStub(userID, options)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.impersonationURLReturns.result1, fake.impersonationURLReturns.result2
+}
+
+func (fake *FakeClient) ImpersonationURLCallCount() int {
+	fake.impersonationURLMutex.RLock()
+	defer fake.impersonationURLMutex.RUnlock()
+	return len(fake.impersonationURLArgsForCall)
+}
+
+func (fake *FakeClient) ImpersonationURLArgsForCall(i int) (string, organization.ImpersonationOptions) {
+	fake.impersonationURLMutex.RLock()
+	defer fake.impersonationURLMutex.RUnlock()
+	return fake.impersonationURLArgsForCall[i].userID, fake.impersonationURLArgsForCall[i].options
+}
+
+func (fake *FakeClient) ImpersonationURLReturns(result1 *models.ImpersonateURL, result2 error) {
+	fake.ImpersonationURLStub = nil
+	fake.impersonationURLReturns = struct {
+		result1 *models.ImpersonateURL
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ImpersonationURLReturnsOnCall(i int, result1 *models.ImpersonateURL, result2 error) {
+	fake.ImpersonationURLStub = nil
+	if fake.impersonationURLReturnsOnCall == nil {
+		fake.impersonationURLReturnsOnCall = make(map[int]struct {
+			result1 *models.ImpersonateURL
+			result2 error
+		})
+	}
+	fake.impersonationURLReturnsOnCall[i] = struct {
+		result1 *models.ImpersonateURL
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ImpersonationURLCtx(ctx context.Context, userID string, options organization.ImpersonationOptions) (*models.ImpersonateURL, error) {
+	fake.impersonationURLCtxMutex.Lock()
+	ret, specificReturn := fake.impersonationURLCtxReturnsOnCall[len(fake.impersonationURLCtxArgsForCall)]
+	fake.impersonationURLCtxArgsForCall = append(fake.impersonationURLCtxArgsForCall, struct {
+		ctx     context.Context
+		userID  string
+		options organization.ImpersonationOptions
+	}{ctx, userID, options})
+	fake.recordInvocation("ImpersonationURLCtx", []interface{}{ctx, userID, options})
+	fake.impersonationURLCtxMutex.Unlock()
+	if fake.ImpersonationURLCtxStub != nil {
+		return fake.ImpersonationURLCtxStub(ctx, userID, options)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.impersonationURLCtxReturns.result1, fake.impersonationURLCtxReturns.result2
+}
+
+func (fake *FakeClient) ImpersonationURLCtxCallCount() int {
+	fake.impersonationURLCtxMutex.RLock()
+	defer fake.impersonationURLCtxMutex.RUnlock()
+	return len(fake.impersonationURLCtxArgsForCall)
+}
+
+func (fake *FakeClient) ImpersonationURLCtxArgsForCall(i int) (context.Context, string, organization.ImpersonationOptions) {
+	fake.impersonationURLCtxMutex.RLock()
+	defer fake.impersonationURLCtxMutex.RUnlock()
+	return fake.impersonationURLCtxArgsForCall[i].ctx, fake.impersonationURLCtxArgsForCall[i].userID, fake.impersonationURLCtxArgsForCall[i].options
+}
+
+func (fake *FakeClient) ImpersonationURLCtxReturns(result1 *models.ImpersonateURL, result2 error) {
+	fake.ImpersonationURLCtxStub = nil
+	fake.impersonationURLCtxReturns = struct {
+		result1 *models.ImpersonateURL
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ImpersonationURLCtxReturnsOnCall(i int, result1 *models.ImpersonateURL, result2 error) {
+	fake.ImpersonationURLCtxStub = nil
+	if fake.impersonationURLCtxReturnsOnCall == nil {
+		fake.impersonationURLCtxReturnsOnCall = make(map[int]struct {
+			result1 *models.ImpersonateURL
+			result2 error
+		})
+	}
+	fake.impersonationURLCtxReturnsOnCall[i] = struct {
+		result1 *models.ImpersonateURL
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.organizationsMutex.RLock()
 	defer fake.organizationsMutex.RUnlock()
+	fake.organizationsCtxMutex.RLock()
+	defer fake.organizationsCtxMutex.RUnlock()
+	fake.organizationsWithOptionsMutex.RLock()
+	defer fake.organizationsWithOptionsMutex.RUnlock()
+	fake.organizationsWithOptionsCtxMutex.RLock()
+	defer fake.organizationsWithOptionsCtxMutex.RUnlock()
 	fake.organizationMutex.RLock()
 	defer fake.organizationMutex.RUnlock()
+	fake.organizationCtxMutex.RLock()
+	defer fake.organizationCtxMutex.RUnlock()
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	fake.createOrganizationCtxMutex.RLock()
+	defer fake.createOrganizationCtxMutex.RUnlock()
+	fake.updateOrganizationMutex.RLock()
+	defer fake.updateOrganizationMutex.RUnlock()
+	fake.updateOrganizationCtxMutex.RLock()
+	defer fake.updateOrganizationCtxMutex.RUnlock()
+	fake.deactivateOrganizationMutex.RLock()
+	defer fake.deactivateOrganizationMutex.RUnlock()
+	fake.deactivateOrganizationCtxMutex.RLock()
+	defer fake.deactivateOrganizationCtxMutex.RUnlock()
 	fake.subscriptionsMutex.RLock()
 	defer fake.subscriptionsMutex.RUnlock()
+	fake.subscriptionsCtxMutex.RLock()
+	defer fake.subscriptionsCtxMutex.RUnlock()
+	fake.subscriptionsWithOptionsMutex.RLock()
+	defer fake.subscriptionsWithOptionsMutex.RUnlock()
+	fake.subscriptionsWithOptionsCtxMutex.RLock()
+	defer fake.subscriptionsWithOptionsCtxMutex.RUnlock()
+	fake.organizationSubscriptionsMutex.RLock()
+	defer fake.organizationSubscriptionsMutex.RUnlock()
+	fake.organizationSubscriptionsCtxMutex.RLock()
+	defer fake.organizationSubscriptionsCtxMutex.RUnlock()
+	fake.subscriptionMutex.RLock()
+	defer fake.subscriptionMutex.RUnlock()
+	fake.subscriptionCtxMutex.RLock()
+	defer fake.subscriptionCtxMutex.RUnlock()
 	fake.updateSubscriptionMutex.RLock()
 	defer fake.updateSubscriptionMutex.RUnlock()
+	fake.updateSubscriptionCtxMutex.RLock()
+	defer fake.updateSubscriptionCtxMutex.RUnlock()
+	fake.createSubscriptionMutex.RLock()
+	defer fake.createSubscriptionMutex.RUnlock()
+	fake.createSubscriptionCtxMutex.RLock()
+	defer fake.createSubscriptionCtxMutex.RUnlock()
+	fake.cancelSubscriptionMutex.RLock()
+	defer fake.cancelSubscriptionMutex.RUnlock()
+	fake.cancelSubscriptionCtxMutex.RLock()
+	defer fake.cancelSubscriptionCtxMutex.RUnlock()
+	fake.reactivateSubscriptionMutex.RLock()
+	defer fake.reactivateSubscriptionMutex.RUnlock()
+	fake.reactivateSubscriptionCtxMutex.RLock()
+	defer fake.reactivateSubscriptionCtxMutex.RUnlock()
 	fake.planMutex.RLock()
 	defer fake.planMutex.RUnlock()
+	fake.planCtxMutex.RLock()
+	defer fake.planCtxMutex.RUnlock()
+	fake.plansMutex.RLock()
+	defer fake.plansMutex.RUnlock()
+	fake.plansCtxMutex.RLock()
+	defer fake.plansCtxMutex.RUnlock()
 	fake.organizationUsersMutex.RLock()
 	defer fake.organizationUsersMutex.RUnlock()
+	fake.organizationUsersCtxMutex.RLock()
+	defer fake.organizationUsersCtxMutex.RUnlock()
+	fake.createOrganizationUserMutex.RLock()
+	defer fake.createOrganizationUserMutex.RUnlock()
+	fake.createOrganizationUserCtxMutex.RLock()
+	defer fake.createOrganizationUserCtxMutex.RUnlock()
+	fake.impersonationURLMutex.RLock()
+	defer fake.impersonationURLMutex.RUnlock()
+	fake.impersonationURLCtxMutex.RLock()
+	defer fake.impersonationURLCtxMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,55 @@
+package organization_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/3dsim/organization-goclient/models"
+	"github.com/3dsim/organization-goclient/organization"
+	"github.com/3dsim/organization-goclient/organization/organizationfakes"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+)
+
+// supportLoginURL is the kind of code that depends on organization.Client and is tested with the fake.
+func supportLoginURL(client organization.Client, userID, engineer string) (string, error) {
+	impersonateURL, err := client.ImpersonationURL(userID, organization.ImpersonationOptions{
+		OrganizationID: swag.Int32(3),
+		RequestedBy:    engineer,
+	})
+	if err != nil {
+		return "", err
+	}
+	return impersonateURL.URL, nil
+}
+
+func TestFakeClientImpersonationURLExpectsStubbedURLAndRecordedArgs(t *testing.T) {
+	// arrange
+	fakeClient := &organizationfakes.FakeClient{}
+	fakeClient.ImpersonationURLReturns(&models.ImpersonateURL{URL: "https://login.example.com/impersonate"}, nil)
+
+	// act
+	url, err := supportLoginURL(fakeClient, "auth0|5", "support@example.com")
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	assert.Equal(t, "https://login.example.com/impersonate", url, "Expected the stubbed url returned")
+	assert.Equal(t, 1, fakeClient.ImpersonationURLCallCount(), "Expected one call")
+	userID, options := fakeClient.ImpersonationURLArgsForCall(0)
+	assert.Equal(t, "auth0|5", userID, "Expected user ids to match")
+	assert.Equal(t, "support@example.com", options.RequestedBy, "Expected requesters to match")
+	assert.Equal(t, int32(3), *options.OrganizationID, "Expected organization ids to match")
+}
+
+func TestFakeClientImpersonationURLWhenErrorStubbedExpectsErrorReturned(t *testing.T) {
+	// arrange
+	expectedError := errors.New("Some organization api error")
+	fakeClient := &organizationfakes.FakeClient{}
+	fakeClient.ImpersonationURLReturns(nil, expectedError)
+
+	// act
+	_, err := supportLoginURL(fakeClient, "auth0|5", "support@example.com")
+
+	// assert
+	assert.Equal(t, expectedError, err, "Expected the stubbed error returned")
+}
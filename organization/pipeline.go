@@ -0,0 +1,249 @@
+package organization
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/PuerkitoBio/rehttp"
+	"github.com/go-openapi/runtime"
+	log "github.com/inconshreveable/log15"
+)
+
+// Interceptor wraps the transport that submits operations to the organization api, e.g. to add behavior before and
+// after every request.  The interceptors of a client form a pipeline around the transport, see WithInterceptors and
+// NewTransport.
+type Interceptor func(next runtime.ClientTransport) runtime.ClientTransport
+
+// TransportFunc adapts a function to runtime.ClientTransport, which is convenient when writing an Interceptor.
+type TransportFunc func(operation *runtime.ClientOperation) (interface{}, error)
+
+// Submit calls f(operation).
+func (f TransportFunc) Submit(operation *runtime.ClientOperation) (interface{}, error) {
+	return f(operation)
+}
+
+// Chain wraps transport with interceptors.  The first interceptor is the outermost, so it sees an operation first and
+// its result last.
+func Chain(transport runtime.ClientTransport, interceptors ...Interceptor) runtime.ClientTransport {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		transport = interceptors[i](transport)
+	}
+	return transport
+}
+
+// WithInterceptors adds interceptors to the pipeline of the client.  They run in the order given, inside the recovery
+// and timeout interceptors and outside the auth interceptor, so they see each operation with its deadline set and
+// once per call, even if the request is sent again with new credentials.
+func WithInterceptors(interceptors ...Interceptor) Option {
+	return func(cfg *config) {
+		cfg.interceptors = append(cfg.interceptors, interceptors...)
+	}
+}
+
+// NewTransport creates the transport used by clients created by New, configured by the same options.  Use it to call
+// operations of the "genclient" package that Client does not provide:
+//
+//	transport, err := organization.NewTransport(opts...)
+//	organizationClient := genclient.New(transport, strfmt.Default)
+//	response, err := organizationClient.Operations.GetPlan(operations.NewGetPlanParams().WithID(1), nil)
+//
+// Operations submitted with a nil auth info are authenticated by the configured credentials.  Every operation goes
+// through the pipeline
+//
+//	RecoveryInterceptor, TimeoutInterceptor, the interceptors given to WithInterceptors, AuthInterceptor
+//
+// and error responses are returned as *APIError.
+func NewTransport(opts ...Option) (runtime.ClientTransport, error) {
+	cfg, err := newConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+	return newTransport(cfg)
+}
+
+// RecoveryInterceptor returns panics raised while submitting an operation as errors.
+func RecoveryInterceptor() Interceptor {
+	return func(next runtime.ClientTransport) runtime.ClientTransport {
+		return TransportFunc(func(operation *runtime.ClientOperation) (result interface{}, err error) {
+			defer func() {
+				// Until this issue is resolved: https://github.com/go-swagger/go-swagger/issues/1021, we need to recover
+				// from panics.
+				if r := recover(); r != nil {
+					result, err = nil, fmt.Errorf("Recovered from panic: %v", r)
+				}
+			}()
+			return next.Submit(operation)
+		})
+	}
+}
+
+// TimeoutInterceptor limits each operation, including fetching credentials and any retries, to timeout.  The
+// generated client ignores the timeout of its params when they carry a context, so the deadline is set on the
+// context of the operation instead.  A timeout of zero or less sets no deadline.
+func TimeoutInterceptor(timeout time.Duration) Interceptor {
+	return func(next runtime.ClientTransport) runtime.ClientTransport {
+		return TransportFunc(func(operation *runtime.ClientOperation) (interface{}, error) {
+			if timeout <= 0 {
+				return next.Submit(operation)
+			}
+			ctx, cancel := context.WithTimeout(operationContext(operation), timeout)
+			defer cancel()
+			limited := *operation
+			limited.Context = ctx
+			return next.Submit(&limited)
+		})
+	}
+}
+
+// AuthInterceptor authenticates operations submitted without auth info with credentials.  If the api responds with
+// status 401 and credentials are RefreshableCredentials, e.g. because a token expired while the request was in
+// flight, the operation is sent once more with new auth info so that credentials the api keeps rejecting are reported
+// to the caller.  Operations submitted with their own auth info are sent as they are.
+func AuthInterceptor(credentials Credentials) Interceptor {
+	return func(next runtime.ClientTransport) runtime.ClientTransport {
+		return TransportFunc(func(operation *runtime.ClientOperation) (interface{}, error) {
+			if operation.AuthInfo != nil {
+				return next.Submit(operation)
+			}
+			ctx := operationContext(operation)
+			authInfo, err := credentials.AuthInfo(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// Credentials may ignore ctx, or finish just as it is done, so check it before sending anything.
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			// The next transports may modify the operation, e.g. wrap its reader, so each attempt gets its own copy.
+			first := *operation
+			first.AuthInfo = authInfo
+			result, err := next.Submit(&first)
+			refreshable, ok := credentials.(RefreshableCredentials)
+			if !ok || !IsUnauthorized(err) {
+				return result, err
+			}
+			refreshable.Invalidate(authInfo)
+			refreshed, authErr := refreshable.AuthInfo(ctx)
+			if authErr != nil || sameToken(refreshed, authInfo) || ctx.Err() != nil {
+				return result, err
+			}
+			second := *operation
+			second.AuthInfo = refreshed
+			return next.Submit(&second)
+		})
+	}
+}
+
+// LoggingInterceptor logs every operation to logger with its duration: at debug level if it succeeded and at warn
+// level, with the error, if it failed.  Requests and responses themselves are logged by WithDebug.
+func LoggingInterceptor(logger log.Logger) Interceptor {
+	return func(next runtime.ClientTransport) runtime.ClientTransport {
+		return TransportFunc(func(operation *runtime.ClientOperation) (interface{}, error) {
+			start := time.Now()
+			result, err := next.Submit(operation)
+			fields := []interface{}{"operation", operation.ID, "method", operation.Method, "path", operation.PathPattern,
+				"duration", time.Since(start)}
+			if err != nil {
+				logger.Warn("Organization api operation failed", append(fields, "status", StatusCode(err), "err", err)...)
+			} else {
+				logger.Debug("Organization api operation succeeded", fields...)
+			}
+			return result, err
+		})
+	}
+}
+
+// MetricsObserver records the outcome of one operation, e.g. in a histogram labeled by operationID and StatusCode(err).
+type MetricsObserver func(operationID string, duration time.Duration, err error)
+
+// MetricsInterceptor calls observe after every operation.
+func MetricsInterceptor(observe MetricsObserver) Interceptor {
+	return func(next runtime.ClientTransport) runtime.ClientTransport {
+		return TransportFunc(func(operation *runtime.ClientOperation) (interface{}, error) {
+			start := time.Now()
+			result, err := next.Submit(operation)
+			observe(operation.ID, time.Since(start), err)
+			return result, err
+		})
+	}
+}
+
+// RetryInterceptor sends failed operations again according to policy until it returns false or the context of the
+// operation is done.  Unlike WithRetryPolicy, which retries HTTP requests in the transport of the http.Client, it
+// retries whole operations, so the attempts are seen by the interceptors after it, including AuthInterceptor.  The
+// Retry-After header is not available to policy.Delay at this level.
+func RetryInterceptor(policy RetryPolicy) Interceptor {
+	return func(next runtime.ClientTransport) runtime.ClientTransport {
+		return TransportFunc(func(operation *runtime.ClientOperation) (interface{}, error) {
+			ctx := operationContext(operation)
+			for index := 0; ; index++ {
+				sent := *operation
+				result, err := next.Submit(&sent)
+				if err == nil {
+					return result, nil
+				}
+				attempt := operationAttempt(operation, index, err)
+				if ctx.Err() != nil || !policy.Retry(attempt) {
+					return result, err
+				}
+				timer := time.NewTimer(policy.Delay(attempt))
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return result, err
+				case <-timer.C:
+				}
+			}
+		})
+	}
+}
+
+// operationAttempt describes a failed operation as a rehttp.Attempt so that a RetryPolicy can decide on it.  Error
+// responses are described by their status code, other errors are passed on as they are.
+func operationAttempt(operation *runtime.ClientOperation, index int, err error) rehttp.Attempt {
+	attempt := rehttp.Attempt{
+		Index: index,
+		Request: &http.Request{
+			Method: operation.Method,
+			URL:    &url.URL{Path: operation.PathPattern},
+			Header: http.Header{},
+		},
+	}
+	if status := StatusCode(err); status != 0 {
+		attempt.Response = &http.Response{StatusCode: status, Header: http.Header{}}
+	} else {
+		attempt.Error = err
+	}
+	return attempt
+}
+
+// StartSpanFunc starts a span for the operation with id operationID and returns a context carrying the span and a
+// function that finishes the span with the result of the operation.  It adapts a tracer, e.g. OpenTracing, to
+// TracingInterceptor.
+type StartSpanFunc func(ctx context.Context, operationID string) (context.Context, func(err error))
+
+// TracingInterceptor traces every operation with startSpan.  The context returned by startSpan is the context of the
+// HTTP request, so a round tripper given to WithRoundTripper can propagate the span to the api.
+func TracingInterceptor(startSpan StartSpanFunc) Interceptor {
+	return func(next runtime.ClientTransport) runtime.ClientTransport {
+		return TransportFunc(func(operation *runtime.ClientOperation) (interface{}, error) {
+			ctx, finish := startSpan(operationContext(operation), operation.ID)
+			traced := *operation
+			traced.Context = ctx
+			result, err := next.Submit(&traced)
+			finish(err)
+			return result, err
+		})
+	}
+}
+
+// operationContext returns the context of operation, or context.Background() if it has none.
+func operationContext(operation *runtime.ClientOperation) context.Context {
+	if operation.Context != nil {
+		return operation.Context
+	}
+	return context.Background()
+}
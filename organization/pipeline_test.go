@@ -0,0 +1,273 @@
+package organization
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/3dsim/organization-goclient/genclient"
+	"github.com/3dsim/organization-goclient/genclient/operations"
+	"github.com/3dsim/organization-goclient/models"
+	"github.com/PuerkitoBio/rehttp"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+)
+
+// planHandler serves a plan, responding first with the given error statuses, and counts the requests.  The description
+// of the plan is the Authorization header of the request.
+func planHandler(requests *int32, statuses ...int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		n := int(atomic.AddInt32(requests, 1))
+		w.Header().Set("Content-Type", "application/json")
+		if n <= len(statuses) {
+			w.WriteHeader(statuses[n-1])
+			w.Write([]byte(`{"code":1,"message":"Failed"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(&models.Plan{ID: 1, Name: swag.String("Plan"), Description: r.Header.Get("Authorization")})
+	}
+}
+
+func recordingInterceptor(name string, calls *[]string) Interceptor {
+	return func(next runtime.ClientTransport) runtime.ClientTransport {
+		return TransportFunc(func(operation *runtime.ClientOperation) (interface{}, error) {
+			*calls = append(*calls, name+" before")
+			result, err := next.Submit(operation)
+			*calls = append(*calls, name+" after")
+			return result, err
+		})
+	}
+}
+
+func TestChainExpectsFirstInterceptorOutermost(t *testing.T) {
+	// arrange
+	var calls []string
+	transport := Chain(TransportFunc(func(*runtime.ClientOperation) (interface{}, error) {
+		calls = append(calls, "transport")
+		return nil, nil
+	}), recordingInterceptor("first", &calls), recordingInterceptor("second", &calls))
+
+	// act
+	transport.Submit(&runtime.ClientOperation{ID: "getPlan"})
+
+	// assert
+	assert.Equal(t, []string{"first before", "second before", "transport", "second after", "first after"}, calls, "Expected the interceptors to run in order")
+}
+
+func TestRecoveryInterceptorWhenPanicExpectsError(t *testing.T) {
+	// arrange
+	transport := Chain(TransportFunc(func(*runtime.ClientOperation) (interface{}, error) {
+		panic("reader failed")
+	}), RecoveryInterceptor())
+
+	// act
+	result, err := transport.Submit(&runtime.ClientOperation{ID: "getPlan"})
+
+	// assert
+	assert.Nil(t, result, "Expected no result")
+	if assert.NotNil(t, err, "Expected the panic returned as an error") {
+		assert.Contains(t, err.Error(), "reader failed", "Expected the panic value in the error")
+	}
+}
+
+func TestWithInterceptorsExpectsEveryOperationInterceptedWithDeadline(t *testing.T) {
+	// arrange
+	var requests int32
+	testServer := newTestServer("/plans/{id}", planHandler(&requests))
+	defer testServer.Close()
+	var operationIDs []string
+	var hasDeadline, hasAuthInfo bool
+	interceptor := func(next runtime.ClientTransport) runtime.ClientTransport {
+		return TransportFunc(func(operation *runtime.ClientOperation) (interface{}, error) {
+			operationIDs = append(operationIDs, operation.ID)
+			_, hasDeadline = operation.Context.Deadline()
+			hasAuthInfo = operation.AuthInfo != nil
+			return next.Submit(operation)
+		})
+	}
+	client, err := New(WithCredentials(StaticToken("static")), WithAPIGatewayURL(testServer.URL), WithAPIBasePath(apiBasePath),
+		WithInterceptors(interceptor))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// act
+	plan, err := client.Plan(1)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	assert.Equal(t, "Bearer static", plan.Description, "Expected the request to be authenticated")
+	assert.Equal(t, []string{"getPlan"}, operationIDs, "Expected the operation to be intercepted")
+	assert.True(t, hasDeadline, "Expected the request timeout to be set")
+	assert.False(t, hasAuthInfo, "Expected the interceptor to run outside the auth interceptor")
+}
+
+func TestLoggingAndMetricsInterceptorsWhenOperationFailsExpectsFailureRecorded(t *testing.T) {
+	// arrange
+	var requests int32
+	testServer := newTestServer("/plans/{id}", planHandler(&requests, http.StatusNotFound))
+	defer testServer.Close()
+	logger, records := recordingLogger()
+	var observedID string
+	var observedErr error
+	observe := func(operationID string, duration time.Duration, err error) {
+		observedID, observedErr = operationID, err
+	}
+	client, err := New(WithCredentials(StaticToken("static")), WithAPIGatewayURL(testServer.URL), WithAPIBasePath(apiBasePath),
+		WithInterceptors(LoggingInterceptor(logger), MetricsInterceptor(observe)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// act
+	_, err = client.Plan(1)
+
+	// assert
+	assert.True(t, IsNotFound(err), "Expected a not found error")
+	assert.Equal(t, "getPlan", observedID, "Expected the operation id observed")
+	assert.Equal(t, http.StatusNotFound, StatusCode(observedErr), "Expected the error observed")
+	if assert.Len(t, records(), 1, "Expected one record") {
+		assert.Contains(t, records()[0], "Organization api operation failed", "Expected the failure logged")
+		assert.Contains(t, records()[0], "operation=getPlan", "Expected the operation id logged")
+		assert.Contains(t, records()[0], "status=404", "Expected the status logged")
+	}
+}
+
+func TestRetryInterceptorWhenTransientErrorExpectsOperationRetried(t *testing.T) {
+	// arrange
+	var requests int32
+	testServer := newTestServer("/plans/{id}", planHandler(&requests, http.StatusServiceUnavailable, http.StatusBadGateway))
+	defer testServer.Close()
+	policy := RetryPolicy{
+		Retry: rehttp.RetryAll(RetryIdempotentMethods(false), RetryTransientErrors()),
+		Delay: rehttp.ConstDelay(time.Millisecond),
+	}
+	client, err := New(WithCredentials(StaticToken("static")), WithAPIGatewayURL(testServer.URL), WithAPIBasePath(apiBasePath),
+		WithInterceptors(RetryInterceptor(policy)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// act
+	plan, err := client.Plan(1)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	assert.NotNil(t, plan, "Expected the plan")
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests), "Expected two retries")
+}
+
+func TestRetryInterceptorWhenClientErrorExpectsNoRetry(t *testing.T) {
+	// arrange
+	var requests int32
+	testServer := newTestServer("/plans/{id}", planHandler(&requests, http.StatusBadRequest))
+	defer testServer.Close()
+	policy := RetryPolicy{Retry: RetryTransientErrors(), Delay: rehttp.ConstDelay(time.Millisecond)}
+	client, err := New(WithCredentials(StaticToken("static")), WithAPIGatewayURL(testServer.URL), WithAPIBasePath(apiBasePath),
+		WithInterceptors(RetryInterceptor(policy)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// act
+	_, err = client.Plan(1)
+
+	// assert
+	assert.Equal(t, http.StatusBadRequest, StatusCode(err), "Expected the bad request error")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests), "Expected no retry")
+}
+
+type spanKey struct{}
+
+func TestTracingInterceptorExpectsSpanInRequestContext(t *testing.T) {
+	// arrange
+	var requests int32
+	testServer := newTestServer("/plans/{id}", planHandler(&requests))
+	defer testServer.Close()
+	var started, finished string
+	startSpan := func(ctx context.Context, operationID string) (context.Context, func(error)) {
+		started = operationID
+		return context.WithValue(ctx, spanKey{}, operationID), func(error) { finished = operationID }
+	}
+	var requestSpan interface{}
+	roundTripper := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		requestSpan = r.Context().Value(spanKey{})
+		return http.DefaultTransport.RoundTrip(r)
+	})
+	client, err := New(WithCredentials(StaticToken("static")), WithAPIGatewayURL(testServer.URL), WithAPIBasePath(apiBasePath),
+		WithInterceptors(TracingInterceptor(startSpan)), WithRoundTripper(roundTripper))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// act
+	_, err = client.Plan(1)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	assert.Equal(t, "getPlan", started, "Expected a span started for the operation")
+	assert.Equal(t, "getPlan", finished, "Expected the span finished")
+	assert.Equal(t, "getPlan", requestSpan, "Expected the span in the context of the HTTP request")
+}
+
+func TestNewTransportExpectsGeneratedOperationsAuthenticated(t *testing.T) {
+	// arrange
+	var requests int32
+	testServer := newTestServer("/plans/{id}", planHandler(&requests))
+	defer testServer.Close()
+	transport, err := NewTransport(WithCredentials(StaticToken("static")), WithAPIGatewayURL(testServer.URL), WithAPIBasePath(apiBasePath))
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+	organizationClient := genclient.New(transport, strfmt.Default)
+
+	// act
+	response, err := organizationClient.Operations.GetPlan(operations.NewGetPlanParams().WithID(1), nil)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	assert.Equal(t, "Bearer static", response.Payload.Description, "Expected the credentials of the transport to be used")
+}
+
+func TestNewTransportWhenCredentialsFailExpectsError(t *testing.T) {
+	// arrange
+	credentials := credentialsFunc(func(context.Context) (runtime.ClientAuthInfoWriter, error) {
+		return nil, errors.New("no credentials")
+	})
+	var requests int32
+	testServer := newTestServer("/plans/{id}", planHandler(&requests))
+	defer testServer.Close()
+	client, err := New(WithCredentials(StaticToken("static")), WithAPIGatewayURL(testServer.URL), WithAPIBasePath(apiBasePath),
+		WithCredentials(credentials))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// act
+	_, err = client.Plan(1)
+
+	// assert
+	if assert.NotNil(t, err, "Expected an error returned") {
+		assert.True(t, strings.Contains(err.Error(), "no credentials"), "Expected the credentials error")
+	}
+	assert.Equal(t, int32(0), atomic.LoadInt32(&requests), "Expected no request sent")
+}
+
+type credentialsFunc func(ctx context.Context) (runtime.ClientAuthInfoWriter, error)
+
+func (f credentialsFunc) AuthInfo(ctx context.Context) (runtime.ClientAuthInfoWriter, error) {
+	return f(ctx)
+}
+
+type roundTripperFunc func(r *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
@@ -0,0 +1,132 @@
+package organization
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/rehttp"
+)
+
+const (
+	// defaultRetryMaxDelay is the longest wait between retries if no positive maxDelay is given.
+	defaultRetryMaxDelay = 30 * time.Second
+	// maxRetries is the number of times TransientRetryPolicy sends a request again before giving up.
+	maxRetries = 3
+)
+
+// RetryPolicy decides which failed requests are retried and how long to wait before each retry.  Requests are retried
+// until Retry returns false or the request timeout of the client elapses.  See TransientRetryPolicy for the policy used
+// by NewClientWithRetry, and the rehttp package for more ready made RetryFn and DelayFn implementations.
+type RetryPolicy struct {
+	Retry rehttp.RetryFn
+	Delay rehttp.DelayFn
+}
+
+// transport wraps next with the retry policy.  A nil next uses http.DefaultTransport.
+func (p RetryPolicy) transport(next http.RoundTripper) http.RoundTripper {
+	return rehttp.NewTransport(next, p.Retry, p.Delay)
+}
+
+// TransientRetryPolicy is the policy used by NewClientWithRetry.  It retries requests that failed with a transient
+// error (see RetryTransientErrors) if it is safe to send them again (see RetryIdempotentMethods).  Retries wait for
+// the Retry-After header of the response if one was sent, otherwise an exponential backoff with jitter.  No retry
+// waits longer than maxDelay, or 30 seconds if maxDelay is zero or less, and a request is retried at most 3 times.
+//
+// PUT requests, e.g. UpdateSubscription, are only retried if retryPUT is true.  The organization api applies a PUT on
+// every request it receives, so only opt in if sending the same update twice is harmless to the caller.
+func TransientRetryPolicy(maxDelay time.Duration, retryPUT bool) RetryPolicy {
+	if maxDelay <= 0 {
+		maxDelay = defaultRetryMaxDelay
+	}
+	return RetryPolicy{
+		Retry: rehttp.RetryAll(rehttp.RetryMaxRetries(maxRetries), RetryIdempotentMethods(retryPUT), RetryTransientErrors()),
+		Delay: RetryAfterDelay(rehttp.ExpJitterDelay(1*time.Second, maxDelay), maxDelay),
+	}
+}
+
+// RetryTransientErrors returns a RetryFn that retries connection errors and responses with status 408 (request
+// timeout), 429 (too many requests) or 5xx.  Other 4xx responses, e.g. validation failures, are never retried since
+// sending the same request again fails the same way.  Errors caused by the request context being canceled or timing
+// out are not retried either.
+func RetryTransientErrors() rehttp.RetryFn {
+	return func(attempt rehttp.Attempt) bool {
+		if attempt.Error != nil {
+			return isConnectionError(attempt.Error)
+		}
+		if attempt.Response == nil {
+			return false
+		}
+		status := attempt.Response.StatusCode
+		return status == http.StatusRequestTimeout || status == http.StatusTooManyRequests || status >= 500
+	}
+}
+
+// RetryIdempotentMethods returns a RetryFn that allows retrying GET, HEAD, OPTIONS and DELETE requests, and PUT
+// requests if retryPUT is true.  POST and PATCH requests are never retried.  Combine it with another RetryFn using
+// rehttp.RetryAll.
+func RetryIdempotentMethods(retryPUT bool) rehttp.RetryFn {
+	methods := []string{http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodDelete}
+	if retryPUT {
+		methods = append(methods, http.MethodPut)
+	}
+	return rehttp.RetryHTTPMethods(methods...)
+}
+
+// RetryAfterDelay returns a DelayFn that waits for the duration given by the Retry-After header of the response,
+// either in seconds or as an HTTP date, but no longer than maxDelay, or 30 seconds if maxDelay is zero or less.  If the
+// response has no valid Retry-After header, fallback is used instead.
+func RetryAfterDelay(fallback rehttp.DelayFn, maxDelay time.Duration) rehttp.DelayFn {
+	if maxDelay <= 0 {
+		maxDelay = defaultRetryMaxDelay
+	}
+	return func(attempt rehttp.Attempt) time.Duration {
+		if attempt.Response != nil {
+			if delay, ok := parseRetryAfter(attempt.Response.Header.Get("Retry-After"), time.Now()); ok {
+				if delay > maxDelay {
+					return maxDelay
+				}
+				return delay
+			}
+		}
+		return fallback(attempt)
+	}
+}
+
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	if delay := date.Sub(now); delay > 0 {
+		return delay, true
+	}
+	return 0, true
+}
+
+// isConnectionError reports whether err was returned because the connection to the server failed, as opposed to the
+// request being canceled by the caller.
+func isConnectionError(err error) bool {
+	if err == context.Canceled || err == context.DeadlineExceeded {
+		return false
+	}
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return true
+	}
+	_, ok := err.(net.Error)
+	return ok
+}
@@ -0,0 +1,329 @@
+package organization
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/3dsim/auth0/auth0fakes"
+	"github.com/3dsim/organization-goclient/models"
+	"github.com/PuerkitoBio/rehttp"
+	"github.com/stretchr/testify/assert"
+)
+
+func attemptWithStatus(method string, status int) rehttp.Attempt {
+	return rehttp.Attempt{
+		Request:  &http.Request{Method: method},
+		Response: &http.Response{StatusCode: status, Header: http.Header{}},
+	}
+}
+
+func TestTransientRetryPolicyExpectsOnlyTransientIdempotentFailuresRetried(t *testing.T) {
+	testCases := []struct {
+		name     string
+		attempt  rehttp.Attempt
+		retryPUT bool
+		expected bool
+	}{
+		{"GET 200", attemptWithStatus(http.MethodGet, 200), false, false},
+		{"GET 400", attemptWithStatus(http.MethodGet, 400), false, false},
+		{"GET 401", attemptWithStatus(http.MethodGet, 401), false, false},
+		{"GET 403", attemptWithStatus(http.MethodGet, 403), false, false},
+		{"GET 404", attemptWithStatus(http.MethodGet, 404), false, false},
+		{"GET 408", attemptWithStatus(http.MethodGet, 408), false, true},
+		{"GET 422", attemptWithStatus(http.MethodGet, 422), false, false},
+		{"GET 429", attemptWithStatus(http.MethodGet, 429), false, true},
+		{"GET 500", attemptWithStatus(http.MethodGet, 500), false, true},
+		{"GET 503", attemptWithStatus(http.MethodGet, 503), false, true},
+		{"DELETE 502", attemptWithStatus(http.MethodDelete, 502), false, true},
+		{"POST 503", attemptWithStatus(http.MethodPost, 503), true, false},
+		{"PUT 503", attemptWithStatus(http.MethodPut, 503), false, false},
+		{"PUT 503 opted in", attemptWithStatus(http.MethodPut, 503), true, true},
+		{"PUT 400 opted in", attemptWithStatus(http.MethodPut, 400), true, false},
+		{"GET connection refused", rehttp.Attempt{Request: &http.Request{Method: http.MethodGet}, Error: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}, false, true},
+		{"GET unexpected EOF", rehttp.Attempt{Request: &http.Request{Method: http.MethodGet}, Error: io.ErrUnexpectedEOF}, false, true},
+		{"GET context canceled", rehttp.Attempt{Request: &http.Request{Method: http.MethodGet}, Error: context.Canceled}, false, false},
+		{"GET context deadline", rehttp.Attempt{Request: &http.Request{Method: http.MethodGet}, Error: context.DeadlineExceeded}, false, false},
+		{"GET other error", rehttp.Attempt{Request: &http.Request{Method: http.MethodGet}, Error: errors.New("Some error")}, false, false},
+		{"PUT connection refused", rehttp.Attempt{Request: &http.Request{Method: http.MethodPut}, Error: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}, false, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// arrange
+			policy := TransientRetryPolicy(time.Second, tc.retryPUT)
+
+			// act
+			retry := policy.Retry(tc.attempt)
+
+			// assert
+			assert.Equal(t, tc.expected, retry, "Expected retry decision to match")
+		})
+	}
+}
+
+func TestRetryAfterDelayWhenHeaderSentExpectsHeaderHonored(t *testing.T) {
+	fallback := rehttp.ConstDelay(7 * time.Millisecond)
+	testCases := []struct {
+		name       string
+		retryAfter string
+		expected   time.Duration
+	}{
+		{"seconds", "2", 2 * time.Second},
+		{"zero", "0", 0},
+		{"capped", "120", 10 * time.Second},
+		{"missing", "", 7 * time.Millisecond},
+		{"negative", "-1", 7 * time.Millisecond},
+		{"invalid", "soon", 7 * time.Millisecond},
+		{"date in the past", "Mon, 02 Jan 2006 15:04:05 GMT", 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// arrange
+			attempt := attemptWithStatus(http.MethodGet, http.StatusTooManyRequests)
+			attempt.Response.Header.Set("Retry-After", tc.retryAfter)
+
+			// act
+			delay := RetryAfterDelay(fallback, 10*time.Second)(attempt)
+
+			// assert
+			assert.Equal(t, tc.expected, delay, "Expected delays to match")
+		})
+	}
+}
+
+func TestParseRetryAfterWhenDateSentExpectsDelayUntilDate(t *testing.T) {
+	// arrange
+	now := time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	// act
+	delay, ok := parseRetryAfter(now.Add(90*time.Second).Format(http.TimeFormat), now)
+
+	// assert
+	assert.True(t, ok, "Expected the date to be parsed")
+	assert.Equal(t, 90*time.Second, delay, "Expected a delay until the date")
+}
+
+func TestRetryAfterDelayWhenNoResponseExpectsFallbackUsed(t *testing.T) {
+	// arrange
+	attempt := rehttp.Attempt{Request: &http.Request{Method: http.MethodGet}, Error: io.EOF}
+
+	// act
+	delay := RetryAfterDelay(rehttp.ConstDelay(time.Second), time.Minute)(attempt)
+
+	// assert
+	assert.Equal(t, time.Second, delay, "Expected the fallback delay")
+}
+
+func TestRetryAfterDelayWhenMaxDelayNotPositiveExpectsDefaultCap(t *testing.T) {
+	for _, maxDelay := range []time.Duration{0, -time.Second} {
+		t.Run(maxDelay.String(), func(t *testing.T) {
+			// arrange
+			attempt := attemptWithStatus(http.MethodGet, http.StatusServiceUnavailable)
+			attempt.Response.Header.Set("Retry-After", "120")
+
+			// act
+			delay := RetryAfterDelay(rehttp.ConstDelay(time.Second), maxDelay)(attempt)
+
+			// assert
+			assert.Equal(t, defaultRetryMaxDelay, delay, "Expected the default maximum delay")
+		})
+	}
+}
+
+func TestTransientRetryPolicyWhenMaxDelayNotPositiveExpectsBoundedDelay(t *testing.T) {
+	for _, maxDelay := range []time.Duration{0, -time.Second} {
+		t.Run(maxDelay.String(), func(t *testing.T) {
+			// arrange
+			policy := TransientRetryPolicy(maxDelay, false)
+			attempt := attemptWithStatus(http.MethodGet, http.StatusServiceUnavailable)
+
+			// act
+			var delays []time.Duration
+			for attempt.Index = 0; attempt.Index < 10; attempt.Index++ {
+				delays = append(delays, policy.Delay(attempt))
+			}
+
+			// assert
+			for _, delay := range delays {
+				assert.True(t, delay >= 0 && delay <= defaultRetryMaxDelay, "Expected delay %v within the default maximum", delay)
+			}
+		})
+	}
+}
+
+func TestTransientRetryPolicyWhenMaxRetriesReachedExpectsNoRetry(t *testing.T) {
+	// arrange
+	policy := TransientRetryPolicy(time.Second, false)
+	attempt := attemptWithStatus(http.MethodGet, http.StatusServiceUnavailable)
+	attempt.Index = maxRetries - 1
+	last := policy.Retry(attempt)
+
+	// act
+	attempt.Index = maxRetries
+	retry := policy.Retry(attempt)
+
+	// assert
+	assert.True(t, last, "Expected the last allowed attempt to be retried")
+	assert.False(t, retry, "Expected no retry once the maximum is reached")
+}
+
+func TestNewClientWithRetryWhen400ExpectsNoRetry(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	var callCounter int32
+	testServer := newTestServer("/plans/{planID}", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&callCounter, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	defer testServer.Close()
+	client := NewClientWithRetry(fakeTokenFetcher, testServer.URL, apiBasePath, audience, 3*time.Second)
+
+	// act
+	_, err := client.Plan(1)
+
+	// assert
+	assert.NotNil(t, err, "Expected an error returned because organization api sent a 400 error")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&callCounter), "Expected a validation failure not to be retried")
+}
+
+func TestNewClientWithRetryWhen429ExpectsRetryAfterHonored(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	var callCounter int32
+	testServer := newTestServer("/plans/{planID}", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&callCounter, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		writePlan(w)
+	})
+	defer testServer.Close()
+	client := NewClientWithRetry(fakeTokenFetcher, testServer.URL, apiBasePath, audience, 3*time.Second)
+
+	// act
+	start := time.Now()
+	plan, err := client.Plan(1)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned because the retry succeeded")
+	assert.NotNil(t, plan, "Expected returned plan to not be nil")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&callCounter), "Expected one retry")
+	assert.True(t, time.Since(start) < time.Second, "Expected the retry to wait for Retry-After instead of the backoff")
+}
+
+func TestNewClientWithRetryWhenRetryTimeoutZeroAndRetryAfterZeroExpectsRetriesStop(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	var callCounter int32
+	testServer := newTestServer("/plans/{planID}", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&callCounter, 1)
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	defer testServer.Close()
+	client := NewClientWithRetry(fakeTokenFetcher, testServer.URL, apiBasePath, audience, 0)
+
+	// act
+	plan, err := client.Plan(1)
+
+	// assert
+	assert.NotNil(t, err, "Expected an error returned because every attempt failed")
+	assert.Nil(t, plan, "Expected returned plan to be nil")
+	assert.Equal(t, int32(1+maxRetries), atomic.LoadInt32(&callCounter), "Expected retries to stop at the maximum")
+}
+
+func TestNewClientWithRetryWhenRetryTimeoutZeroAndNoRetryAfterExpectsBackoff(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	var callCounter int32
+	testServer := newTestServer("/plans/{planID}", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&callCounter, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	defer testServer.Close()
+	client := NewClientWithRetry(fakeTokenFetcher, testServer.URL, apiBasePath, audience, 0)
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	// act
+	plan, err := client.PlanCtx(ctx, 1)
+
+	// assert
+	assert.NotNil(t, err, "Expected an error returned because the context deadline elapsed during the backoff")
+	assert.Nil(t, plan, "Expected returned plan to be nil")
+	assert.True(t, atomic.LoadInt32(&callCounter) <= 1+maxRetries, "Expected no more requests than the maximum retries allow")
+}
+
+func TestNewClientWithRetryWhenUpdateSubscriptionFailsExpectsNoRetry(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	var callCounter int32
+	testServer := newTestServer("/organizations/1/subscriptions/1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected the subscription to be put")
+		if atomic.AddInt32(&callCounter, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":1,"organizationId":1}`))
+	})
+	defer testServer.Close()
+	client := NewClientWithRetry(fakeTokenFetcher, testServer.URL, apiBasePath, audience, 3*time.Second)
+
+	// act
+	_, err := client.UpdateSubscription(&models.Subscription{ID: 1, OrganizationID: 1})
+
+	// assert
+	assert.NotNil(t, err, "Expected an error returned because organization api sent a 503 error")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&callCounter), "Expected the PUT not to be retried")
+}
+
+func TestNewWithTransientRetryPolicyWhenPUTOptedInExpectsUpdateSubscriptionRetried(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	var callCounter int32
+	testServer := newTestServer("/organizations/1/subscriptions/1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected the subscription to be put")
+		if atomic.AddInt32(&callCounter, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":1,"organizationId":1}`))
+	})
+	defer testServer.Close()
+	client, err := New(
+		WithTokenFetcher(fakeTokenFetcher),
+		WithAPIGatewayURL(testServer.URL),
+		WithAPIBasePath(apiBasePath),
+		WithAudience(audience),
+		WithRetryPolicy(TransientRetryPolicy(time.Second, true)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// act
+	subscription, err := client.UpdateSubscription(&models.Subscription{ID: 1, OrganizationID: 1})
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned because the retry succeeded")
+	assert.NotNil(t, subscription, "Expected returned subscription to not be nil")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&callCounter), "Expected the PUT to be retried once")
+}
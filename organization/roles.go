@@ -0,0 +1,83 @@
+package organization
+
+import (
+	"github.com/3dsim/organization-goclient/models"
+)
+
+// Role is a role a user has in an organization, as stored in the Auth0 app metadata of the user.
+type Role string
+
+// The roles defined by the organization api.
+const (
+	RoleAdmin          Role = "Admin"
+	RoleUser           Role = "User"
+	RoleSuperAdmin     Role = "SuperAdmin"
+	RoleAccountManager Role = "AccountManager"
+)
+
+// RolesIn returns the roles user has in the organization with id orgID, in the order they are stored and without
+// duplicates.  It returns nil if user is nil or has no permissions in the organization.
+func RolesIn(user *models.User, orgID int32) []Role {
+	var roles []Role
+	for _, org := range permittedOrganizations(user) {
+		if org == nil || org.OrganizationID != orgID {
+			continue
+		}
+		for _, name := range org.Roles {
+			if role := Role(name); !containsRole(roles, role) {
+				roles = append(roles, role)
+			}
+		}
+	}
+	return roles
+}
+
+// HasRole reports whether user has role in the organization with id orgID.  Roles do not imply each other, e.g. a
+// SuperAdmin does not have the Admin role unless it is granted separately; use IsSuperAdmin to check for super admins.
+func HasRole(user *models.User, orgID int32, role Role) bool {
+	return containsRole(RolesIn(user, orgID), role)
+}
+
+// IsSuperAdmin reports whether user has the SuperAdmin role in any organization.  Super admins may act on every
+// organization.
+func IsSuperAdmin(user *models.User) bool {
+	for _, org := range permittedOrganizations(user) {
+		if org == nil {
+			continue
+		}
+		for _, name := range org.Roles {
+			if Role(name) == RoleSuperAdmin {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AdminsOf returns the users that have the Admin role in the organization with id orgID, in the order given.
+func AdminsOf(users []*models.User, orgID int32) []*models.User {
+	var admins []*models.User
+	for _, user := range users {
+		if HasRole(user, orgID, RoleAdmin) {
+			admins = append(admins, user)
+		}
+	}
+	return admins
+}
+
+// permittedOrganizations returns the organizations in the app metadata of user, walking the optional fields safely.
+func permittedOrganizations(user *models.User) []*models.Auth0Organization {
+	if user == nil || user.AppMetadata == nil || user.AppMetadata.Permissions == nil {
+		return nil
+	}
+	return user.AppMetadata.Permissions.Organizations
+}
+
+func containsRole(roles []Role, role Role) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
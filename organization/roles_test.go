@@ -0,0 +1,89 @@
+package organization
+
+import (
+	"testing"
+
+	"github.com/3dsim/organization-goclient/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func userWithRoles(id string, organizations ...*models.Auth0Organization) *models.User {
+	return &models.User{
+		UserID: id,
+		AppMetadata: &models.Auth0AppMetadata{
+			Permissions: &models.Auth0Permissions{Organizations: organizations},
+		},
+	}
+}
+
+func TestRolesInWhenUserHasRolesInOrganizationExpectsDeduplicatedRoles(t *testing.T) {
+	// arrange
+	user := userWithRoles("auth0|1",
+		&models.Auth0Organization{OrganizationID: 10, Roles: []string{"User", "Admin"}},
+		&models.Auth0Organization{OrganizationID: 20, Roles: []string{"AccountManager"}},
+		&models.Auth0Organization{OrganizationID: 10, Roles: []string{"Admin"}},
+	)
+
+	// act
+	roles := RolesIn(user, 10)
+
+	// assert
+	assert.Equal(t, []Role{RoleUser, RoleAdmin}, roles, "Expected the roles of organization 10 only")
+	assert.True(t, HasRole(user, 10, RoleAdmin), "Expected the user to be an admin of organization 10")
+	assert.False(t, HasRole(user, 20, RoleAdmin), "Expected the user not to be an admin of organization 20")
+	assert.True(t, HasRole(user, 20, RoleAccountManager), "Expected the user to be an account manager of organization 20")
+}
+
+func TestRolesInWhenMetadataMissingExpectsNoRoles(t *testing.T) {
+	users := []*models.User{
+		nil,
+		{UserID: "auth0|1"},
+		{UserID: "auth0|2", AppMetadata: &models.Auth0AppMetadata{}},
+		userWithRoles("auth0|3", nil),
+	}
+	for _, user := range users {
+		// act
+		roles := RolesIn(user, 10)
+
+		// assert
+		assert.Nil(t, roles, "Expected no roles")
+		assert.False(t, HasRole(user, 10, RoleUser), "Expected the user not to have a role")
+		assert.False(t, IsSuperAdmin(user), "Expected the user not to be a super admin")
+	}
+}
+
+func TestIsSuperAdminWhenSuperAdminInAnyOrganizationExpectsTrue(t *testing.T) {
+	// arrange
+	superAdmin := userWithRoles("auth0|1", &models.Auth0Organization{OrganizationID: 1, Roles: []string{"SuperAdmin"}})
+	admin := userWithRoles("auth0|2", &models.Auth0Organization{OrganizationID: 10, Roles: []string{"Admin"}})
+
+	// act
+	isSuperAdmin := IsSuperAdmin(superAdmin)
+	isAdmin := HasRole(superAdmin, 10, RoleAdmin)
+	adminIsSuperAdmin := IsSuperAdmin(admin)
+
+	// assert
+	assert.True(t, isSuperAdmin, "Expected the user to be a super admin")
+	assert.False(t, isAdmin, "Expected super admin not to imply admin")
+	assert.False(t, adminIsSuperAdmin, "Expected an admin not to be a super admin")
+}
+
+func TestAdminsOfExpectsAdminsOfOrganizationInOrder(t *testing.T) {
+	// arrange
+	users := []*models.User{
+		userWithRoles("auth0|1", &models.Auth0Organization{OrganizationID: 10, Roles: []string{"Admin"}}),
+		userWithRoles("auth0|2", &models.Auth0Organization{OrganizationID: 10, Roles: []string{"User"}}),
+		userWithRoles("auth0|3", &models.Auth0Organization{OrganizationID: 20, Roles: []string{"Admin"}}),
+		nil,
+		userWithRoles("auth0|4", &models.Auth0Organization{OrganizationID: 10, Roles: []string{"User", "Admin"}}),
+	}
+
+	// act
+	admins := AdminsOf(users, 10)
+
+	// assert
+	if assert.Len(t, admins, 2, "Expected 2 admins") {
+		assert.Equal(t, "auth0|1", admins[0].UserID, "Expected admins in the order given")
+		assert.Equal(t, "auth0|4", admins[1].UserID, "Expected admins in the order given")
+	}
+}
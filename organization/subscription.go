@@ -0,0 +1,83 @@
+package organization
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/3dsim/organization-goclient/models"
+	"github.com/go-openapi/strfmt"
+)
+
+// SubscriptionTransitionError is returned by CancelSubscription and ReactivateSubscription when the subscription is not
+// in a state the transition applies to, e.g. when cancelling a subscription that is already canceled.  The api is not
+// called to update the subscription in that case.
+type SubscriptionTransitionError struct {
+	OrganizationID int32
+	SubscriptionID int32
+	// Transition is "cancel" or "reactivate".
+	Transition string
+	// Reason describes the state of the subscription that prevented the transition.
+	Reason string
+}
+
+func (e *SubscriptionTransitionError) Error() string {
+	return fmt.Sprintf("cannot %s subscription %d of organization %d: %s", e.Transition, e.SubscriptionID,
+		e.OrganizationID, e.Reason)
+}
+
+// IsSubscriptionTransitionError reports whether err is a *SubscriptionTransitionError.
+func IsSubscriptionTransitionError(err error) bool {
+	_, ok := err.(*SubscriptionTransitionError)
+	return ok
+}
+
+// newSubscription returns an active subscription to planID for the organization.
+func newSubscription(organizationID, planID int32, paymentMethod string) *models.Subscription {
+	return &models.Subscription{
+		Active:         true,
+		OrganizationID: organizationID,
+		PlanID:         planID,
+		PaymentMethod:  paymentMethod,
+	}
+}
+
+// cancelSubscription sets the fields of subscription that mark it canceled by canceledBy at canceledAt.  A subscription
+// that is already canceled or inactive cannot be canceled.
+func cancelSubscription(subscription *models.Subscription, canceledBy string, canceledAt time.Time) error {
+	if subscription.CanceledAt != nil {
+		return transitionError(subscription, "cancel", fmt.Sprintf("already canceled at %v", subscription.CanceledAt))
+	}
+	if !subscription.Active {
+		return transitionError(subscription, "cancel", "subscription is not active")
+	}
+	at := strfmt.DateTime(canceledAt.UTC())
+	subscription.Active = false
+	subscription.CanceledAt = &at
+	subscription.CanceledBy = canceledBy
+	subscription.LastModifiedBy = canceledBy
+	return nil
+}
+
+// reactivateSubscription clears the fields set by cancelSubscription.  Only a canceled subscription can be reactivated.
+func reactivateSubscription(subscription *models.Subscription, reactivatedBy string) error {
+	if subscription.CanceledAt == nil {
+		if subscription.Active {
+			return transitionError(subscription, "reactivate", "subscription is active")
+		}
+		return transitionError(subscription, "reactivate", "subscription is inactive but was not canceled")
+	}
+	subscription.Active = true
+	subscription.CanceledAt = nil
+	subscription.CanceledBy = ""
+	subscription.LastModifiedBy = reactivatedBy
+	return nil
+}
+
+func transitionError(subscription *models.Subscription, transition, reason string) error {
+	return &SubscriptionTransitionError{
+		OrganizationID: subscription.OrganizationID,
+		SubscriptionID: subscription.ID,
+		Transition:     transition,
+		Reason:         reason,
+	}
+}
@@ -0,0 +1,96 @@
+package organization
+
+import (
+	"testing"
+	"time"
+
+	"github.com/3dsim/organization-goclient/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+)
+
+func canceledSubscription() *models.Subscription {
+	canceledAt := strfmt.DateTime(time.Date(2017, 5, 1, 0, 0, 0, 0, time.UTC))
+	return &models.Subscription{ID: 2, OrganizationID: 1, CanceledAt: &canceledAt, CanceledBy: "someone@example.com"}
+}
+
+func TestCancelSubscriptionWhenActiveExpectsCanceledFieldsSet(t *testing.T) {
+	// arrange
+	subscription := &models.Subscription{ID: 2, OrganizationID: 1, Active: true}
+	now := time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	// act
+	err := cancelSubscription(subscription, "admin@example.com", now)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	assert.False(t, subscription.Active, "Expected the subscription to be inactive")
+	if assert.NotNil(t, subscription.CanceledAt, "Expected canceled at to be set") {
+		assert.Equal(t, now, time.Time(*subscription.CanceledAt), "Expected canceled at to be now")
+	}
+	assert.Equal(t, "admin@example.com", subscription.CanceledBy, "Expected canceled by to be set")
+	assert.Equal(t, "admin@example.com", subscription.LastModifiedBy, "Expected last modified by to be set")
+}
+
+func TestCancelSubscriptionWhenCanceledOrInactiveExpectsTransitionError(t *testing.T) {
+	testCases := []struct {
+		name         string
+		subscription *models.Subscription
+	}{
+		{"canceled", canceledSubscription()},
+		{"inactive", &models.Subscription{ID: 2, OrganizationID: 1}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// arrange
+			before := *tc.subscription
+
+			// act
+			err := cancelSubscription(tc.subscription, "admin@example.com", time.Now())
+
+			// assert
+			assert.True(t, IsSubscriptionTransitionError(err), "Expected a transition error")
+			assert.Equal(t, before, *tc.subscription, "Expected the subscription to be unchanged")
+		})
+	}
+}
+
+func TestReactivateSubscriptionWhenCanceledExpectsCanceledFieldsCleared(t *testing.T) {
+	// arrange
+	subscription := canceledSubscription()
+
+	// act
+	err := reactivateSubscription(subscription, "admin@example.com")
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	assert.True(t, subscription.Active, "Expected the subscription to be active")
+	assert.Nil(t, subscription.CanceledAt, "Expected canceled at to be cleared")
+	assert.Empty(t, subscription.CanceledBy, "Expected canceled by to be cleared")
+	assert.Equal(t, "admin@example.com", subscription.LastModifiedBy, "Expected last modified by to be set")
+}
+
+func TestReactivateSubscriptionWhenNotCanceledExpectsTransitionError(t *testing.T) {
+	testCases := []struct {
+		name         string
+		subscription *models.Subscription
+	}{
+		{"active", &models.Subscription{ID: 2, OrganizationID: 1, Active: true}},
+		{"inactive", &models.Subscription{ID: 2, OrganizationID: 1}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// act
+			err := reactivateSubscription(tc.subscription, "admin@example.com")
+
+			// assert
+			if assert.True(t, IsSubscriptionTransitionError(err), "Expected a transition error") {
+				transitionErr := err.(*SubscriptionTransitionError)
+				assert.Equal(t, "reactivate", transitionErr.Transition, "Expected transitions to match")
+				assert.Equal(t, int32(2), transitionErr.SubscriptionID, "Expected subscription ids to match")
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package organization
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/3dsim/auth0"
+	"github.com/go-openapi/runtime"
+)
+
+// DefaultTokenExpirySkew is how long before the exp claim of a token the client stops using it, so that a token does
+// not expire while a request is in flight.  See WithTokenExpirySkew.
+const DefaultTokenExpirySkew = time.Minute
+
+// WithTokenExpirySkew sets how long before the exp claim of a cached token a new token is fetched from the token
+// fetcher.  The default is DefaultTokenExpirySkew.
+func WithTokenExpirySkew(skew time.Duration) Option {
+	return func(cfg *config) {
+		cfg.tokenExpirySkew = skew
+	}
+}
+
+// tokenFetcherCredentials are the Credentials of a client created with a token fetcher.  Tokens are cached until
+// shortly before they expire and refreshed when the api rejects them.
+type tokenFetcherCredentials struct {
+	tokens   *tokenCache
+	audience string
+}
+
+// TokenFetcherCredentials returns Credentials that authenticate requests with bearer tokens for audience fetched by
+// tokenFetcher.  Tokens are reused until expirySkew before their exp claim, and fetched again when the api rejects
+// them with status 401.  This is how clients created with a token fetcher authenticate.
+func TokenFetcherCredentials(tokenFetcher auth0.TokenFetcher, audience string, expirySkew time.Duration) RefreshableCredentials {
+	return &tokenFetcherCredentials{tokens: newTokenCache(tokenFetcher, expirySkew), audience: audience}
+}
+
+func (c *tokenFetcherCredentials) AuthInfo(ctx context.Context) (runtime.ClientAuthInfoWriter, error) {
+	token, err := c.tokens.token(ctx, c.audience)
+	if err != nil {
+		return nil, err
+	}
+	return bearer(token), nil
+}
+
+func (c *tokenFetcherCredentials) Invalidate(rejected runtime.ClientAuthInfoWriter) {
+	if token, ok := rejected.(bearer); ok {
+		c.tokens.invalidate(c.audience, string(token))
+	}
+}
+
+// tokenCache holds the tokens fetched by a token fetcher per audience until they are about to expire.  Tokens that are
+// not JWTs with an exp claim are never cached.  It is safe for concurrent use.
+type tokenCache struct {
+	fetcher auth0.TokenFetcher
+	skew    time.Duration
+	now     func() time.Time
+
+	mu     sync.Mutex
+	tokens map[string]cachedToken
+}
+
+type cachedToken struct {
+	token   string
+	expires time.Time
+}
+
+func newTokenCache(fetcher auth0.TokenFetcher, skew time.Duration) *tokenCache {
+	return &tokenCache{fetcher: fetcher, skew: skew, now: time.Now, tokens: make(map[string]cachedToken)}
+}
+
+// token returns the cached token for audience, or fetches a new one.  The token fetcher does not accept a context, so
+// the fetch runs in its own goroutine and is abandoned if ctx is done first.  No fetch is started if ctx is already done.
+func (c *tokenCache) token(ctx context.Context, audience string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	c.mu.Lock()
+	cached, ok := c.tokens[audience]
+	c.mu.Unlock()
+	if ok && c.now().Add(c.skew).Before(cached.expires) {
+		return cached.token, nil
+	}
+
+	type result struct {
+		token string
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		token, err := c.fetcher.Token(audience)
+		done <- result{token, err}
+	}()
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case r := <-done:
+		if r.err != nil {
+			return "", r.err
+		}
+		if expires, ok := tokenExpiry(r.token); ok {
+			c.mu.Lock()
+			c.tokens[audience] = cachedToken{token: r.token, expires: expires}
+			c.mu.Unlock()
+		}
+		return r.token, nil
+	}
+}
+
+// invalidate removes token from the cache if it is still the cached token for audience, so that a token fetched by a
+// concurrent request after token was rejected is kept.
+func (c *tokenCache) invalidate(audience, token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cached, ok := c.tokens[audience]; ok && cached.token == token {
+		delete(c.tokens, audience)
+	}
+}
+
+// tokenExpiry returns the time given by the exp claim of token if it is a JWT.  The signature is not verified since
+// the token is only read to know when to fetch a new one.
+func tokenExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, false
+	}
+	var claims struct {
+		Exp *float64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == nil {
+		return time.Time{}, false
+	}
+	seconds := int64(*claims.Exp)
+	return time.Unix(seconds, int64((*claims.Exp-float64(seconds))*1e9)), true
+}
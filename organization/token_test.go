@@ -0,0 +1,174 @@
+package organization
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/3dsim/auth0/auth0fakes"
+	"github.com/3dsim/organization-goclient/models"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+)
+
+// jwt returns an unsigned JWT with the given subject and exp claim.
+func jwt(subject string, exp time.Time) string {
+	encode := func(v interface{}) string {
+		bytes, _ := json.Marshal(v)
+		return base64.RawURLEncoding.EncodeToString(bytes)
+	}
+	header := encode(map[string]string{"alg": "RS256", "typ": "JWT"})
+	claims := encode(map[string]interface{}{"sub": subject, "exp": exp.Unix()})
+	return header + "." + claims + ".signature"
+}
+
+// tokenSequence returns a token fetcher stub that returns tokens in order, repeating the last one.
+func tokenSequence(tokens ...string) func(string) (string, error) {
+	var calls int32
+	return func(string) (string, error) {
+		i := int(atomic.AddInt32(&calls, 1)) - 1
+		if i >= len(tokens) {
+			i = len(tokens) - 1
+		}
+		return tokens[i], nil
+	}
+}
+
+func TestTokenExpiryWhenJWTExpectsExpClaim(t *testing.T) {
+	// arrange
+	exp := time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	// act
+	expires, ok := tokenExpiry(jwt("client", exp))
+
+	// assert
+	assert.True(t, ok, "Expected the exp claim to be read")
+	assert.True(t, exp.Equal(expires), "Expected the exp claim")
+}
+
+func TestTokenExpiryWhenNotJWTExpectsFalse(t *testing.T) {
+	for _, token := range []string{"Token", "a.b.c", "a." + base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"x"}`)) + ".c"} {
+		// act
+		_, ok := tokenExpiry(token)
+
+		// assert
+		assert.False(t, ok, "Expected no expiry for "+token)
+	}
+}
+
+func TestTokenCacheWhenTokenValidExpectsCachedTokenUntilSkew(t *testing.T) {
+	// arrange
+	now := time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC)
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenStub = tokenSequence(jwt("first", now.Add(10*time.Minute)), jwt("second", now.Add(20*time.Minute)))
+	cache := newTokenCache(fakeTokenFetcher, time.Minute)
+	cache.now = func() time.Time { return now }
+
+	// act
+	first, _ := cache.token(context.Background(), audience)
+	cached, _ := cache.token(context.Background(), audience)
+	now = now.Add(9 * time.Minute)
+	refreshed, _ := cache.token(context.Background(), audience)
+
+	// assert
+	assert.Equal(t, first, cached, "Expected the cached token")
+	assert.Equal(t, jwt("second", time.Date(2017, 6, 1, 12, 20, 0, 0, time.UTC)), refreshed, "Expected a new token within the skew")
+	assert.Equal(t, 2, fakeTokenFetcher.TokenCallCount(), "Expected the token fetcher to be called twice")
+	assert.Equal(t, audience, fakeTokenFetcher.TokenArgsForCall(0), "Expected the token to be fetched for the audience")
+}
+
+func TestTokenCacheWhenTokenHasNoExpiryExpectsTokenNotCached(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("Token", nil)
+	cache := newTokenCache(fakeTokenFetcher, time.Minute)
+
+	// act
+	cache.token(context.Background(), audience)
+	cache.token(context.Background(), audience)
+
+	// assert
+	assert.Equal(t, 2, fakeTokenFetcher.TokenCallCount(), "Expected the token fetcher to be called every time")
+}
+
+func TestTokenCacheInvalidateWhenTokenReplacedExpectsNewTokenKept(t *testing.T) {
+	// arrange
+	exp := time.Now().Add(time.Hour)
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns(jwt("current", exp), nil)
+	cache := newTokenCache(fakeTokenFetcher, time.Minute)
+	cache.token(context.Background(), audience)
+
+	// act
+	cache.invalidate(audience, jwt("old", exp))
+	cache.token(context.Background(), audience)
+	cache.invalidate(audience, jwt("current", exp))
+	cache.token(context.Background(), audience)
+
+	// assert
+	assert.Equal(t, 2, fakeTokenFetcher.TokenCallCount(), "Expected only the current token to be invalidated")
+}
+
+// authenticatedPlanHandler serves a plan to requests whose header matches accepted and responds with status 401 to all
+// others.  It counts the requests.
+func authenticatedPlanHandler(header string, accepted *atomic.Value, requests *int32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get(header) != accepted.Load().(string) {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"code":401,"message":"Unauthorized"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(&models.Plan{ID: 1, Name: swag.String("Plan")})
+	}
+}
+
+func TestPlanWhenTokenRejectedExpectsRetriedWithNewToken(t *testing.T) {
+	// arrange
+	exp := time.Now().Add(time.Hour)
+	expired, fresh := jwt("expired", exp), jwt("fresh", exp)
+	var accepted atomic.Value
+	accepted.Store("Bearer " + fresh)
+	var requests int32
+	testServer := newTestServer("/plans/{id}", authenticatedPlanHandler("Authorization", &accepted, &requests))
+	defer testServer.Close()
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenStub = tokenSequence(expired, fresh)
+	client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+
+	// act
+	plan, err := client.Plan(1)
+	_, secondErr := client.Plan(1)
+
+	// assert
+	assert.Nil(t, err, "Expected no error returned")
+	assert.Equal(t, int32(1), plan.ID, "Expected the plan")
+	assert.Nil(t, secondErr, "Expected no error returned")
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests), "Expected one retry and the new token to be reused")
+	assert.Equal(t, 2, fakeTokenFetcher.TokenCallCount(), "Expected a new token to be fetched once")
+}
+
+func TestPlanWhenNewTokenRejectedExpectsUnauthorizedAfterOneRetry(t *testing.T) {
+	// arrange
+	var accepted atomic.Value
+	accepted.Store("Bearer never")
+	var requests int32
+	testServer := newTestServer("/plans/{id}", authenticatedPlanHandler("Authorization", &accepted, &requests))
+	defer testServer.Close()
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenStub = tokenSequence("first", "second", "third")
+	client := NewClient(fakeTokenFetcher, testServer.URL, apiBasePath, audience)
+
+	// act
+	_, err := client.Plan(1)
+
+	// assert
+	assert.True(t, IsUnauthorized(err), "Expected an unauthorized error")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests), "Expected the request to be retried once")
+	assert.Equal(t, 2, fakeTokenFetcher.TokenCallCount(), "Expected one new token to be fetched")
+}